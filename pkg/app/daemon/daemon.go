@@ -11,6 +11,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
+	"github.com/stefanhaller/git-todo-parser/todo"
 )
 
 // Sometimes lazygit will be invoked in daemon mode from a parent lazygit process.
@@ -39,6 +40,9 @@ const (
 	DaemonKindDropMergeCommit
 	DaemonKindMoveFixupCommitDown
 	DaemonKindWriteRebaseTodo
+	DaemonKindInsertTodo
+	DaemonKindReplaceTodo
+	DaemonKindRemoveTodo
 )
 
 const (
@@ -61,6 +65,9 @@ func getInstruction() Instruction {
 		DaemonKindMoveTodosDown:                   deserializeInstruction[*MoveTodosDownInstruction],
 		DaemonKindInsertBreak:                     deserializeInstruction[*InsertBreakInstruction],
 		DaemonKindWriteRebaseTodo:                 deserializeInstruction[*WriteRebaseTodoInstruction],
+		DaemonKindInsertTodo:                      deserializeInstruction[*InsertTodoInstruction],
+		DaemonKindReplaceTodo:                     deserializeInstruction[*ReplaceTodoInstruction],
+		DaemonKindRemoveTodo:                      deserializeInstruction[*RemoveTodoInstruction],
 	}
 
 	return mapping[getDaemonKind()](jsonData)
@@ -365,3 +372,85 @@ func (self *WriteRebaseTodoInstruction) run(common *common.Common) error {
 		return os.WriteFile(path, self.TodosFileContent, 0o644)
 	})
 }
+
+// Inserts a todo after the todo with the given index (see utils.InsertTodo).
+// Used for todos that have no commit, such as exec, label, reset, or merge.
+type InsertTodoInstruction struct {
+	TodoIndex int
+	Todo      todo.Todo
+}
+
+func NewInsertTodoInstruction(todoIndex int, newTodo todo.Todo) Instruction {
+	return &InsertTodoInstruction{
+		TodoIndex: todoIndex,
+		Todo:      newTodo,
+	}
+}
+
+func (self *InsertTodoInstruction) Kind() DaemonKind {
+	return DaemonKindInsertTodo
+}
+
+func (self *InsertTodoInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *InsertTodoInstruction) run(common *common.Common) error {
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.InsertTodo(path, self.TodoIndex, self.Todo, getCommentChar())
+	})
+}
+
+type ReplaceTodoInstruction struct {
+	TodoIndex  int
+	OldCommand todo.TodoCommand
+	Todo       todo.Todo
+}
+
+func NewReplaceTodoInstruction(todoIndex int, oldCommand todo.TodoCommand, newTodo todo.Todo) Instruction {
+	return &ReplaceTodoInstruction{
+		TodoIndex:  todoIndex,
+		OldCommand: oldCommand,
+		Todo:       newTodo,
+	}
+}
+
+func (self *ReplaceTodoInstruction) Kind() DaemonKind {
+	return DaemonKindReplaceTodo
+}
+
+func (self *ReplaceTodoInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *ReplaceTodoInstruction) run(common *common.Common) error {
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.ReplaceTodo(path, self.TodoIndex, self.OldCommand, self.Todo, getCommentChar())
+	})
+}
+
+type RemoveTodoInstruction struct {
+	TodoIndex  int
+	OldCommand todo.TodoCommand
+}
+
+func NewRemoveTodoInstruction(todoIndex int, oldCommand todo.TodoCommand) Instruction {
+	return &RemoveTodoInstruction{
+		TodoIndex:  todoIndex,
+		OldCommand: oldCommand,
+	}
+}
+
+func (self *RemoveTodoInstruction) Kind() DaemonKind {
+	return DaemonKindRemoveTodo
+}
+
+func (self *RemoveTodoInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *RemoveTodoInstruction) run(common *common.Common) error {
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.RemoveTodo(path, self.TodoIndex, self.OldCommand, getCommentChar())
+	})
+}
@@ -18,27 +18,37 @@ import (
 
 // GitCommand is our main git interface
 type GitCommand struct {
-	Blame       *git_commands.BlameCommands
-	Branch      *git_commands.BranchCommands
-	Commit      *git_commands.CommitCommands
-	Config      *git_commands.ConfigCommands
-	Custom      *git_commands.CustomCommands
-	Diff        *git_commands.DiffCommands
-	File        *git_commands.FileCommands
-	Flow        *git_commands.FlowCommands
-	Patch       *git_commands.PatchCommands
-	Rebase      *git_commands.RebaseCommands
-	Remote      *git_commands.RemoteCommands
-	Stash       *git_commands.StashCommands
-	Status      *git_commands.StatusCommands
-	Submodule   *git_commands.SubmoduleCommands
-	Sync        *git_commands.SyncCommands
-	Tag         *git_commands.TagCommands
-	WorkingTree *git_commands.WorkingTreeCommands
-	Bisect      *git_commands.BisectCommands
-	Worktree    *git_commands.WorktreeCommands
-	Version     *git_commands.GitVersion
-	RepoPaths   *git_commands.RepoPaths
+	Blame          *git_commands.BlameCommands
+	Branch         *git_commands.BranchCommands
+	Commit         *git_commands.CommitCommands
+	Config         *git_commands.ConfigCommands
+	Custom         *git_commands.CustomCommands
+	Diff           *git_commands.DiffCommands
+	File           *git_commands.FileCommands
+	Flow           *git_commands.FlowCommands
+	Hook           *git_commands.HookCommands
+	Lfs            *git_commands.LfsCommands
+	SparseCheckout *git_commands.SparseCheckoutCommands
+	RangeDiff      *git_commands.RangeDiffCommands
+	PatchSeries    *git_commands.PatchSeriesCommands
+	Rerere         *git_commands.RerereCommands
+	Maintenance    *git_commands.MaintenanceCommands
+	CommitTesting  *git_commands.CommitTestingCommands
+	BranchStack    *git_commands.BranchStackCommands
+	Notes          *git_commands.NotesCommands
+	Patch          *git_commands.PatchCommands
+	Rebase         *git_commands.RebaseCommands
+	Remote         *git_commands.RemoteCommands
+	Stash          *git_commands.StashCommands
+	Status         *git_commands.StatusCommands
+	Submodule      *git_commands.SubmoduleCommands
+	Sync           *git_commands.SyncCommands
+	Tag            *git_commands.TagCommands
+	WorkingTree    *git_commands.WorkingTreeCommands
+	Bisect         *git_commands.BisectCommands
+	Worktree       *git_commands.WorktreeCommands
+	Version        *git_commands.GitVersion
+	RepoPaths      *git_commands.RepoPaths
 
 	Loaders Loaders
 }
@@ -137,6 +147,15 @@ func NewGitCommandAux(
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	hookCommands := git_commands.NewHookCommands(gitCommon)
+	notesCommands := git_commands.NewNotesCommands(gitCommon)
+	lfsCommands := git_commands.NewLfsCommands(gitCommon)
+	sparseCheckoutCommands := git_commands.NewSparseCheckoutCommands(gitCommon)
+	rangeDiffCommands := git_commands.NewRangeDiffCommands(gitCommon)
+	patchSeriesCommands := git_commands.NewPatchSeriesCommands(gitCommon)
+	rerereCommands := git_commands.NewRerereCommands(gitCommon)
+	maintenanceCommands := git_commands.NewMaintenanceCommands(gitCommon)
+	commitTestingCommands := git_commands.NewCommitTestingCommands(gitCommon)
 
 	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
@@ -147,27 +166,39 @@ func NewGitCommandAux(
 	stashLoader := git_commands.NewStashLoader(cmn, cmd)
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
+	branchStackCommands := git_commands.NewBranchStackCommands(gitCommon, branchLoader)
+
 	return &GitCommand{
-		Blame:       blameCommands,
-		Branch:      branchCommands,
-		Commit:      commitCommands,
-		Config:      configCommands,
-		Custom:      customCommands,
-		Diff:        diffCommands,
-		File:        fileCommands,
-		Flow:        flowCommands,
-		Patch:       patchCommands,
-		Rebase:      rebaseCommands,
-		Remote:      remoteCommands,
-		Stash:       stashCommands,
-		Status:      statusCommands,
-		Submodule:   submoduleCommands,
-		Sync:        syncCommands,
-		Tag:         tagCommands,
-		Bisect:      bisectCommands,
-		WorkingTree: workingTreeCommands,
-		Worktree:    worktreeCommands,
-		Version:     version,
+		Blame:          blameCommands,
+		Branch:         branchCommands,
+		Commit:         commitCommands,
+		Config:         configCommands,
+		Custom:         customCommands,
+		Diff:           diffCommands,
+		File:           fileCommands,
+		Flow:           flowCommands,
+		Hook:           hookCommands,
+		Lfs:            lfsCommands,
+		SparseCheckout: sparseCheckoutCommands,
+		RangeDiff:      rangeDiffCommands,
+		PatchSeries:    patchSeriesCommands,
+		Rerere:         rerereCommands,
+		Maintenance:    maintenanceCommands,
+		CommitTesting:  commitTestingCommands,
+		BranchStack:    branchStackCommands,
+		Notes:          notesCommands,
+		Patch:          patchCommands,
+		Rebase:         rebaseCommands,
+		Remote:         remoteCommands,
+		Stash:          stashCommands,
+		Status:         statusCommands,
+		Submodule:      submoduleCommands,
+		Sync:           syncCommands,
+		Tag:            tagCommands,
+		Bisect:         bisectCommands,
+		WorkingTree:    workingTreeCommands,
+		Worktree:       worktreeCommands,
+		Version:        version,
 		Loaders: Loaders{
 			BranchLoader:       branchLoader,
 			CommitFileLoader:   commitFileLoader,
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type BisectCommands struct {
@@ -134,6 +136,16 @@ func (self *BisectCommands) StartWithTerms(oldTerm string, newTerm string) error
 	return self.cmd.New(cmdArgs).StreamOutput().Run()
 }
 
+// RunCmdObj lets git test the remaining commits with the given shell command.
+// The command's stderr is merged into its stdout so that the whole output can
+// be read line by line.
+func (self *BisectCommands) RunCmdObj(command string) *oscommands.CmdObj {
+	shellCmdObj := self.cmd.NewShell("("+command+") 2>&1", self.UserConfig().OS.ShellFunctionsFile)
+	cmdArgs := NewGitCmd("bisect").Arg("run").Arg(shellCmdObj.Args()...).ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 // tells us whether we've found our problem commit(s). We return a string slice of
 // commit hashes if we're done, and that slice may have more that one item if
 // skipped commits are involved.
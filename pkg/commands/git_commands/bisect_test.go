@@ -0,0 +1,23 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBisectRunCmdObj(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"bisect", "run", "bash", "-c", "(make test) 2>&1"}, "running  'bash' '-c' '(make test) 2>&1'\nok\n", nil)
+	instance := buildBisectCommands(commonDeps{runner: runner})
+
+	lines := []string{}
+	err := instance.RunCmdObj("make test").RunAndProcessLines(func(line string) (bool, error) {
+		lines = append(lines, line)
+		return false, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"running  'bash' '-c' '(make test) 2>&1'", "ok"}, lines)
+	runner.CheckForMissingCalls()
+}
@@ -2,6 +2,10 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
 type BlameCommands struct {
@@ -31,3 +35,96 @@ func (self *BlameCommands) BlameLineRange(filename string, commit string, firstL
 
 	return self.cmd.New(cmdArgs.ToArgv()).RunWithOutput()
 }
+
+type BlameOptions struct {
+	// Ignore whitespace changes when looking for the commit that changed a line (-w)
+	IgnoreWhitespace bool
+	// Detect lines that were moved or copied within the file (-M)
+	DetectMoves bool
+	// Detect lines that were moved or copied from other files that were
+	// modified in the same commit (-C)
+	DetectCopies bool
+}
+
+// Blame a whole file as of the given commit, or as in the working tree if the
+// commit is empty.
+func (self *BlameCommands) GetBlame(filename string, commit string, opts BlameOptions) ([]*models.BlameLine, error) {
+	cmdArgs := NewGitCmd("blame").
+		Arg("--line-porcelain").
+		ArgIf(opts.IgnoreWhitespace, "-w").
+		ArgIf(opts.DetectMoves, "-M").
+		ArgIf(opts.DetectCopies, "-C").
+		ArgIf(commit != "", commit).
+		Arg("--").
+		Arg(filename).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseBlameOutput(output), nil
+}
+
+// ParseBlameOutput parses the output of `git blame --line-porcelain`, which
+// repeats the full commit information for every line, e.g.
+//
+//	ceec2d92dca8f72e9124ab3e92b9705bc7c641b3 2 2 1
+//	author Jane Doe
+//	author-mail <jane@example.com>
+//	author-time 1700000000
+//	author-tz +0000
+//	committer Jane Doe
+//	...
+//	summary Fix typo
+//	previous 6e946b4049200368c7a8a6bf9cd01c631e6840b9 README.md
+//	filename README.md
+//		the content of the line
+func ParseBlameOutput(output string) []*models.BlameLine {
+	lines := []*models.BlameLine{}
+	var current *models.BlameLine
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			originalLineNumber, _ := strconv.Atoi(fields[1])
+			lineNumber, _ := strconv.Atoi(fields[2])
+			current = &models.BlameLine{
+				Hash:               fields[0],
+				OriginalLineNumber: originalLineNumber,
+				LineNumber:         lineNumber,
+			}
+			continue
+		}
+
+		// The line's content comes last, and is the only line that starts with a tab
+		if content, ok := strings.CutPrefix(line, "\t"); ok {
+			current.Content = content
+			lines = append(lines, current)
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			current.Author = value
+		case "author-mail":
+			current.AuthorEmail = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case "author-time":
+			current.AuthorTimestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "summary":
+			current.Summary = value
+		case "previous":
+			current.PreviousHash, current.PreviousPath, _ = strings.Cut(value, " ")
+		case "filename":
+			current.OriginalPath = value
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,100 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlame(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		commit       string
+		opts         BlameOptions
+		expectedArgs []string
+	}{
+		{
+			testName:     "working tree",
+			commit:       "",
+			opts:         BlameOptions{},
+			expectedArgs: []string{"blame", "--line-porcelain", "--", "file.txt"},
+		},
+		{
+			testName:     "commit with all options",
+			commit:       "abc123",
+			opts:         BlameOptions{IgnoreWhitespace: true, DetectMoves: true, DetectCopies: true},
+			expectedArgs: []string{"blame", "--line-porcelain", "-w", "-M", "-C", "abc123", "--", "file.txt"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildBlameCommands(commonDeps{runner: runner})
+
+			lines, err := instance.GetBlame("file.txt", s.commit, s.opts)
+			assert.NoError(t, err)
+			assert.Empty(t, lines)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestParseBlameOutput(t *testing.T) {
+	output := `6e946b4049200368c7a8a6bf9cd01c631e6840b9 1 1 1
+author Jane Doe
+author-mail <jane@example.com>
+author-time 1700000000
+author-tz +0000
+committer Jane Doe
+committer-mail <jane@example.com>
+committer-time 1700000000
+committer-tz +0000
+summary Add file
+boundary
+filename old.txt
+	first line
+ceec2d92dca8f72e9124ab3e92b9705bc7c641b3 2 2 1
+author John Doe
+author-mail <john@example.com>
+author-time 1700000100
+author-tz +0100
+committer John Doe
+committer-mail <john@example.com>
+committer-time 1700000100
+committer-tz +0100
+summary Change second line
+previous 6e946b4049200368c7a8a6bf9cd01c631e6840b9 old.txt
+filename file.txt
+		indented line
+`
+
+	assert.Equal(t, []*models.BlameLine{
+		{
+			Hash:               "6e946b4049200368c7a8a6bf9cd01c631e6840b9",
+			OriginalLineNumber: 1,
+			OriginalPath:       "old.txt",
+			LineNumber:         1,
+			Author:             "Jane Doe",
+			AuthorEmail:        "jane@example.com",
+			AuthorTimestamp:    1700000000,
+			Summary:            "Add file",
+			Content:            "first line",
+		},
+		{
+			Hash:               "ceec2d92dca8f72e9124ab3e92b9705bc7c641b3",
+			OriginalLineNumber: 2,
+			OriginalPath:       "file.txt",
+			LineNumber:         2,
+			Author:             "John Doe",
+			AuthorEmail:        "john@example.com",
+			AuthorTimestamp:    1700000100,
+			Summary:            "Change second line",
+			PreviousHash:       "6e946b4049200368c7a8a6bf9cd01c631e6840b9",
+			PreviousPath:       "old.txt",
+			Content:            "\tindented line",
+		},
+	}, ParseBlameOutput(output))
+}
@@ -0,0 +1,381 @@
+package git_commands
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type BranchStackCommands struct {
+	*GitCommon
+	branchLoader *BranchLoader
+}
+
+func NewBranchStackCommands(gitCommon *GitCommon, branchLoader *BranchLoader) *BranchStackCommands {
+	return &BranchStackCommands{
+		GitCommon:    gitCommon,
+		branchLoader: branchLoader,
+	}
+}
+
+// GetStack returns the stack that the given branch is part of, or nil if there
+// is none, i.e. if no other local branch is based on it and it isn't based on
+// any other local branch.
+//
+// A branch is based on another one if the point where it forked off the other
+// one (as determined by "git merge-base --fork-point", which also finds it
+// after the other branch was rebased or amended) is one of its own commits,
+// i.e. not on a main branch. If several branches qualify, the one that it
+// forked off most recently is its base.
+func (self *BranchStackCommands) GetStack(
+	branches []*models.Branch,
+	branchName string,
+	mainBranches *MainBranches,
+) (*models.BranchStack, error) {
+	mainBranchRefs := mainBranches.Get()
+	if len(mainBranchRefs) == 0 {
+		return nil, nil
+	}
+
+	candidateBranches := lo.Filter(branches, func(b *models.Branch, _ int) bool {
+		return !b.DetachedHead && b.CommitHash != "" &&
+			!lo.Contains(self.UserConfig().Git.MainBranches, b.Name)
+	})
+	candidates := lo.Map(candidateBranches, func(b *models.Branch, _ int) string { return b.Name })
+	if !lo.Contains(candidates, branchName) {
+		return nil, nil
+	}
+
+	finder := &stackFinder{
+		cmds:       self,
+		candidates: candidates,
+		forkPoints: map[[2]string]string{},
+	}
+	if err := finder.load(candidateBranches, mainBranchRefs); err != nil {
+		return nil, err
+	}
+
+	names := []string{branchName}
+	for {
+		base := finder.baseOf(names[0], names)
+		if base == "" {
+			break
+		}
+		names = utils.Prepend(names, base)
+	}
+
+	for {
+		child := finder.childOf(names[len(names)-1], names)
+		if child == "" {
+			break
+		}
+		names = append(names, child)
+	}
+
+	if len(names) < 2 {
+		return nil, nil
+	}
+
+	stack := &models.BranchStack{}
+	for i, name := range names {
+		stackBranch := &models.StackBranch{Name: name}
+		var baseRef string
+		if i == 0 {
+			bottom, _ := lo.Find(branches, func(b *models.Branch) bool { return b.Name == name })
+			baseBranch, err := self.branchLoader.GetBaseBranch(bottom, mainBranches)
+			if err != nil {
+				return nil, err
+			}
+			stackBranch.Base = baseBranch
+			stackBranch.ForkPoint = mainBranches.GetMergeBase("refs/heads/" + name)
+			baseRef = baseBranch
+		} else {
+			stackBranch.Base = names[i-1]
+			stackBranch.ForkPoint = finder.forkPoint(names[i-1], name)
+			baseRef = "refs/heads/" + names[i-1]
+		}
+
+		if stackBranch.ForkPoint != "" {
+			ahead, err := self.countCommits(stackBranch.ForkPoint, "refs/heads/"+name)
+			if err != nil {
+				return nil, err
+			}
+			stackBranch.Ahead = ahead
+		}
+		if baseRef != "" {
+			behind, err := self.countCommits("refs/heads/"+name, baseRef)
+			if err != nil {
+				return nil, err
+			}
+			stackBranch.Behind = behind
+		}
+
+		stack.Branches = append(stack.Branches, stackBranch)
+	}
+
+	return stack, nil
+}
+
+// GetStackKey returns a string that changes whenever the result of GetStack
+// for the same arguments may have changed, i.e. when the checked out branch,
+// the head of any local branch, or the head of any main branch changes. It is
+// much cheaper to compute than the stack itself, so it can be used to avoid
+// recomputing the stack on every refresh.
+func (self *BranchStackCommands) GetStackKey(
+	branches []*models.Branch,
+	branchName string,
+	mainBranches *MainBranches,
+) string {
+	parts := []string{branchName}
+	for _, branch := range branches {
+		parts = append(parts, branch.Name+" "+branch.CommitHash)
+	}
+
+	mainBranchRefs := mainBranches.Get()
+	if len(mainBranchRefs) > 0 {
+		output, _, err := self.cmd.New(
+			NewGitCmd("rev-parse").Arg(mainBranchRefs...).ToArgv(),
+		).DontLog().RunWithOutputs()
+		if err != nil {
+			// Returning a key that doesn't match the previous one makes the
+			// caller recompute the stack, which is the safe choice
+			return ""
+		}
+		parts = append(parts, output)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// Returns the commit where the given branch forked off the base branch, or an
+// empty string if they have no common history
+func (self *BranchStackCommands) forkPoint(base string, branch string) string {
+	output, _, err := self.cmd.New(
+		NewGitCmd("merge-base").
+			Arg("--fork-point", "refs/heads/"+base, "refs/heads/"+branch).
+			ToArgv(),
+	).DontLog().RunWithOutputs()
+	if err == nil && strings.TrimSpace(output) != "" {
+		return strings.TrimSpace(output)
+	}
+
+	// --fork-point fails if the base's reflog doesn't go back far enough; the
+	// plain merge base is still right if the base hasn't moved since
+	output, _, _ = self.cmd.New(
+		NewGitCmd("merge-base").
+			Arg("refs/heads/"+base, "refs/heads/"+branch).
+			ToArgv(),
+	).DontLog().RunWithOutputs()
+	return strings.TrimSpace(output)
+}
+
+// Returns the number of commits that are reachable from "to" but not "from"
+func (self *BranchStackCommands) countCommits(from string, to string) (int, error) {
+	output, err := self.cmd.New(
+		NewGitCmd("rev-list").
+			Arg("--count", from+".."+to).
+			ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(output))
+}
+
+type stackFinder struct {
+	cmds       *BranchStackCommands
+	candidates []string
+	// For each branch, the commits that aren't on a main branch, mapped to
+	// their distance from the branch head
+	ownCommits map[string]map[string]int
+	// For each branch, the commits that it pointed to according to its reflog
+	reflogCommits map[string][]string
+	// Fork points by base and branch name
+	forkPoints map[[2]string]string
+}
+
+// Loads the own commits of all candidates in a single rev-list call, and the
+// reflogs of those that have any in a single log call, so that we only need to
+// look for fork points between branches that share some history
+func (self *stackFinder) load(branches []*models.Branch, mainBranchRefs []string) error {
+	revs := lo.Map(branches, func(b *models.Branch, _ int) string { return b.CommitHash })
+	for _, ref := range mainBranchRefs {
+		revs = append(revs, "^"+ref)
+	}
+	output, err := self.cmds.cmd.New(
+		NewGitCmd("rev-list").
+			Arg("--parents", "--stdin").
+			ToArgv(),
+	).SetStdin(strings.Join(revs, "\n") + "\n").
+		DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	parents := map[string][]string{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			parents[fields[0]] = fields[1:]
+		}
+	}
+
+	self.ownCommits = map[string]map[string]int{}
+	branchesWithOwnCommits := []string{}
+	for _, branch := range branches {
+		commits := ownCommitDistances(branch.CommitHash, parents)
+		self.ownCommits[branch.Name] = commits
+		if len(commits) > 0 {
+			branchesWithOwnCommits = append(branchesWithOwnCommits, "refs/heads/"+branch.Name)
+		}
+	}
+
+	self.reflogCommits = map[string][]string{}
+	if len(branchesWithOwnCommits) == 0 {
+		return nil
+	}
+
+	output, err = self.cmds.cmd.New(
+		NewGitCmd("log").
+			Arg("--walk-reflogs", "--format=%H %gD", "--stdin").
+			ToArgv(),
+	).SetStdin(strings.Join(branchesWithOwnCommits, "\n") + "\n").
+		DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	for _, line := range utils.SplitLines(output) {
+		// e.g. "0123abc refs/heads/feature@{2}"
+		hash, selector, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+		ref, _, _ := strings.Cut(selector, "@{")
+		name := strings.TrimPrefix(ref, "refs/heads/")
+		self.reflogCommits[name] = append(self.reflogCommits[name], hash)
+	}
+
+	return nil
+}
+
+// Returns the commits that are reachable from the given head through the given
+// parents, mapped to their distance from the head
+func ownCommitDistances(head string, parents map[string][]string) map[string]int {
+	distances := map[string]int{}
+	if _, ok := parents[head]; !ok {
+		return distances
+	}
+
+	distances[head] = 0
+	queue := []string{head}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		for _, parent := range parents[hash] {
+			if _, ok := parents[parent]; !ok {
+				continue
+			}
+			if _, seen := distances[parent]; !seen {
+				distances[parent] = distances[hash] + 1
+				queue = append(queue, parent)
+			}
+		}
+	}
+
+	return distances
+}
+
+func (self *stackFinder) forkPoint(base string, branch string) string {
+	key := [2]string{base, branch}
+	if forkPoint, ok := self.forkPoints[key]; ok {
+		return forkPoint
+	}
+
+	forkPoint := self.cmds.forkPoint(base, branch)
+	self.forkPoints[key] = forkPoint
+	return forkPoint
+}
+
+// Whether the branch contains any of the base's own commits, or any commit
+// that the base pointed to at some point. If it doesn't, the branch can't have
+// forked off the base, so we don't need to ask git for the fork point.
+func (self *stackFinder) sharesHistory(base string, branch string) bool {
+	ownCommits := self.ownCommits[branch]
+	contains := func(hash string) bool {
+		_, ok := ownCommits[hash]
+		return ok
+	}
+
+	for hash := range self.ownCommits[base] {
+		if contains(hash) {
+			return true
+		}
+	}
+	return lo.SomeBy(self.reflogCommits[base], contains)
+}
+
+// Returns the distance of the point where the branch forked off the base from
+// the branch head, or -1 if the branch isn't based on the base. A distance of
+// 0 would mean that the base is at or above the branch head, so we don't
+// consider that as being based on it either.
+func (self *stackFinder) distanceFromBase(base string, branch string) int {
+	// A branch without commits of its own is on a main branch (or merged into
+	// one), so it can't be a base or be based on one
+	ownCommits := self.ownCommits[branch]
+	if len(self.ownCommits[base]) == 0 || len(ownCommits) == 0 || !self.sharesHistory(base, branch) {
+		return -1
+	}
+
+	distance, ok := ownCommits[self.forkPoint(base, branch)]
+	if !ok || distance == 0 {
+		return -1
+	}
+	return distance
+}
+
+// Returns the branch that the given branch is based on, or an empty string if
+// there is none
+func (self *stackFinder) baseOf(branch string, exclude []string) string {
+	base := ""
+	closestDistance := -1
+	for _, candidate := range self.candidates {
+		if candidate == branch || lo.Contains(exclude, candidate) {
+			continue
+		}
+
+		distance := self.distanceFromBase(candidate, branch)
+		if distance != -1 && (closestDistance == -1 || distance < closestDistance) {
+			base = candidate
+			closestDistance = distance
+		}
+	}
+
+	return base
+}
+
+// Returns the first branch that is based on the given branch, or an empty
+// string if there is none
+func (self *stackFinder) childOf(branch string, exclude []string) string {
+	for _, candidate := range self.candidates {
+		if candidate == branch || lo.Contains(exclude, candidate) {
+			continue
+		}
+
+		if self.distanceFromBase(branch, candidate) == -1 {
+			continue
+		}
+
+		// The candidate might be based on a branch that is itself based on
+		// the given branch
+		if self.baseOf(candidate, nil) == branch {
+			return candidate
+		}
+	}
+
+	return ""
+}
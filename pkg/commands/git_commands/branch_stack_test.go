@@ -0,0 +1,83 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchStackGetStack(t *testing.T) {
+	branches := []*models.Branch{
+		{Name: "feature-b", CommitHash: "b2"},
+		{Name: "master", CommitHash: "m2"},
+		{Name: "feature-a", CommitHash: "a2"},
+		{Name: "other", CommitHash: "o1"},
+		{Name: "merged", CommitHash: "m1"},
+	}
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master\n", nil).
+		// a1 is the commit of feature-a before it was amended; merged has no
+		// commits of its own
+		ExpectGitArgs([]string{"rev-list", "--parents", "--stdin"}, "b2 b1\nb1 a1\na1 m1\na2 m1\no1 m1\n", nil).
+		ExpectGitArgs([]string{"log", "--walk-reflogs", "--format=%H %gD", "--stdin"},
+			"b2 refs/heads/feature-b@{0}\na2 refs/heads/feature-a@{0}\na1 refs/heads/feature-a@{1}\no1 refs/heads/other@{0}\n", nil).
+		// other doesn't share any history with feature-a or feature-b, so we
+		// only look for the fork point of feature-b with feature-a
+		ExpectGitArgs([]string{"merge-base", "--fork-point", "refs/heads/feature-a", "refs/heads/feature-b"}, "a1\n", nil).
+		ExpectGitArgs([]string{"merge-base", "refs/heads/feature-a", "refs/remotes/origin/master"}, "m1\n", nil).
+		ExpectGitArgs([]string{"merge-base", "refs/heads/feature-a", "refs/remotes/origin/master"}, "m1\n", nil).
+		ExpectGitArgs([]string{"for-each-ref", "--contains", "m1", "--format=%(refname)", "refs/remotes/origin/master"}, "refs/remotes/origin/master\n", nil).
+		ExpectGitArgs([]string{"rev-list", "--count", "m1..refs/heads/feature-a"}, "1\n", nil).
+		ExpectGitArgs([]string{"rev-list", "--count", "refs/heads/feature-a..refs/remotes/origin/master"}, "0\n", nil).
+		ExpectGitArgs([]string{"rev-list", "--count", "a1..refs/heads/feature-b"}, "2\n", nil).
+		ExpectGitArgs([]string{"rev-list", "--count", "refs/heads/feature-b..refs/heads/feature-a"}, "1\n", nil)
+
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.MainBranches = []string{"master"}
+	deps := commonDeps{runner: runner, userConfig: userConfig}
+	instance := buildBranchStackCommands(deps)
+	mainBranches := NewMainBranches(instance.Common, instance.cmd)
+
+	stack, err := instance.GetStack(branches, "feature-b", mainBranches)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.BranchStack{
+		Branches: []*models.StackBranch{
+			{Name: "feature-a", Base: "refs/remotes/origin/master", ForkPoint: "m1", Ahead: 1, Behind: 0},
+			{Name: "feature-b", Base: "feature-a", ForkPoint: "a1", Ahead: 2, Behind: 1},
+		},
+	}, stack)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchStackGetStackKey(t *testing.T) {
+	branches := []*models.Branch{
+		{Name: "feature-b", CommitHash: "b2"},
+		{Name: "master", CommitHash: "m2"},
+	}
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master\n", nil).
+		ExpectGitArgs([]string{"rev-parse", "refs/remotes/origin/master"}, "m3\n", nil).
+		ExpectGitArgs([]string{"rev-parse", "refs/remotes/origin/master"}, "m4\n", nil).
+		ExpectGitArgs([]string{"rev-parse", "refs/remotes/origin/master"}, "", errors.New("error"))
+
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.MainBranches = []string{"master"}
+	deps := commonDeps{runner: runner, userConfig: userConfig}
+	instance := buildBranchStackCommands(deps)
+	mainBranches := NewMainBranches(instance.Common, instance.cmd)
+
+	key := instance.GetStackKey(branches, "feature-b", mainBranches)
+	assert.Equal(t, "feature-b\nfeature-b b2\nmaster m2\nm3\n", key)
+
+	// the main branch has moved
+	assert.NotEqual(t, key, instance.GetStackKey(branches, "feature-b", mainBranches))
+
+	assert.Equal(t, "", instance.GetStackKey(branches, "feature-b", mainBranches))
+	runner.CheckForMissingCalls()
+}
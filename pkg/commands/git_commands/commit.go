@@ -2,14 +2,24 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
 
+var trailerLineRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*\s*:`)
+
 type CommitCommands struct {
 	*GitCommon
 }
@@ -73,6 +83,157 @@ func AddCoAuthorToDescription(description string, author string) string {
 	return description + fmt.Sprintf("Co-authored-by: %s", author)
 }
 
+// A commit message trailer, e.g. 'Reviewed-by: John Doe <john@doe.com>'
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+func (self Trailer) String() string {
+	return self.Key + ": " + self.Value
+}
+
+// GetTrailers returns the trailers of the given commit message, as parsed by
+// git interpret-trailers
+func (self *CommitCommands) GetTrailers(message string) ([]Trailer, error) {
+	cmdArgs := NewGitCmd("interpret-trailers").
+		Arg("--parse", "--no-divider").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).SetStdin(message).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTrailers(output), nil
+}
+
+func parseTrailers(output string) []Trailer {
+	trailers := []Trailer{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			continue
+		}
+		trailers = append(trailers, Trailer{Key: key, Value: strings.TrimSpace(value)})
+	}
+	return trailers
+}
+
+// SetTrailers returns the given commit message with its trailers replaced by
+// the given ones. The trailers are added using git interpret-trailers, so that
+// the user's trailer config (e.g. trailer.separators) is respected.
+func (self *CommitCommands) SetTrailers(message string, trailers []Trailer) (string, error) {
+	existingTrailers, err := self.GetTrailers(message)
+	if err != nil {
+		return "", err
+	}
+
+	message = strings.TrimSpace(message)
+	if len(existingTrailers) > 0 {
+		message = RemoveTrailerLines(message, existingTrailers)
+	}
+	if len(trailers) == 0 {
+		return message, nil
+	}
+
+	cmdArgs := NewGitCmd("interpret-trailers").
+		Arg("--no-divider", "--where", "end", "--if-exists", "add", "--if-missing", "add").
+		Arg(lo.Map(trailers, func(trailer Trailer, _ int) string {
+			return "--trailer=" + trailer.String()
+		})...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).SetStdin(message + "\n").DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// EditTrailers amends the trailers of the head commit, whose hash is given,
+// using the edit function. The commit is left alone if its trailers don't
+// change.
+func (self *CommitCommands) EditTrailers(hash string, edit func([]Trailer) []Trailer) error {
+	message, err := self.GetCommitMessage(hash)
+	if err != nil {
+		return err
+	}
+
+	trailers, err := self.GetTrailers(message)
+	if err != nil {
+		return err
+	}
+
+	newTrailers := edit(trailers)
+	if slices.Equal(trailers, newTrailers) {
+		return nil
+	}
+
+	message, err = self.SetTrailers(message, newTrailers)
+	if err != nil {
+		return err
+	}
+
+	cmdArgs := NewGitCmd("commit").
+		Arg("--allow-empty", "--amend", "--only", "-m", message).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// RemoveTrailerLines removes the given trailers, as parsed by GetTrailers,
+// along with their continuation lines from the last paragraph of the given
+// message. Other lines that look like trailers are kept. The summary line is
+// never touched.
+func RemoveTrailerLines(message string, trailers []Trailer) string {
+	lastParagraphStart := strings.LastIndex(message, "\n\n")
+	if lastParagraphStart == -1 {
+		return message
+	}
+
+	// Group the lines into trailers with their continuation lines, and other
+	// lines
+	groups := [][]string{}
+	for _, line := range strings.Split(message[lastParagraphStart+2:], "\n") {
+		isContinuation := len(groups) > 0 && trailerLineRegexp.MatchString(groups[len(groups)-1][0]) &&
+			(strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"))
+		if isContinuation {
+			groups[len(groups)-1] = append(groups[len(groups)-1], line)
+		} else {
+			groups = append(groups, []string{line})
+		}
+	}
+
+	remainingTrailers := slices.Clone(trailers)
+	keptLines := []string{}
+	for _, group := range groups {
+		if idx := slices.IndexFunc(remainingTrailers, func(trailer Trailer) bool {
+			return trailerMatchesLines(trailer, group)
+		}); idx != -1 {
+			remainingTrailers = slices.Delete(remainingTrailers, idx, idx+1)
+			continue
+		}
+		keptLines = append(keptLines, group...)
+	}
+
+	result := message[:lastParagraphStart]
+	if len(keptLines) > 0 {
+		result += "\n\n" + strings.Join(keptLines, "\n")
+	}
+	return strings.TrimSpace(result)
+}
+
+// Returns true if the given lines are the given trailer with its continuation
+// lines. git interpret-trailers joins continuation lines when parsing, so we
+// compare the values regardless of how they are wrapped.
+func trailerMatchesLines(trailer Trailer, lines []string) bool {
+	if !trailerLineRegexp.MatchString(lines[0]) {
+		return false
+	}
+
+	key, value, _ := strings.Cut(strings.Join(lines, " "), ":")
+	return strings.EqualFold(strings.TrimSpace(key), trailer.Key) &&
+		strings.Join(strings.Fields(value), " ") == strings.Join(strings.Fields(trailer.Value), " ")
+}
+
 // ResetToCommit reset to commit
 func (self *CommitCommands) ResetToCommit(hash string, strength string, envVars []string) error {
 	cmdArgs := NewGitCmd("reset").Arg("--"+strength, hash).ToArgv()
@@ -127,6 +288,11 @@ func (self *CommitCommands) RewordLastCommit(summary string, description string)
 	return self.cmd.New(cmdArgs)
 }
 
+type CommitMessage struct {
+	Summary     string
+	Description string
+}
+
 func (self *CommitCommands) commitMessageArgs(summary string, description string) []string {
 	args := []string{"-m", summary}
 
@@ -244,15 +410,24 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 
 	extDiffCmd := self.pagerConfig.GetExternalDiffCommand()
 	useExtDiffGitConfig := self.pagerConfig.GetUseExternalDiffGitConfig()
+	showSignature := self.UserConfig().Gui.ShowCommitSignatures
+	// git show only shows the notes of the default notes ref unless we ask for
+	// more, and asking for more turns the default one off unless we pass
+	// --notes too
+	notesRefs := extraNotesRefs(self.GitCommon)
 	cmdArgs := NewGitCmd("show").
 		Config("diff.noprefix=false").
 		ConfigIf(extDiffCmd != "", "diff.external="+extDiffCmd).
+		ConfigIf(showSignature && self.IsMissingAllowedSignersFile(), "gpg.ssh.allowedSignersFile="+os.DevNull).
 		ArgIfElse(extDiffCmd != "" || useExtDiffGitConfig, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg("--color="+self.pagerConfig.GetColorArg()).
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(showSignature, "--show-signature").
+		ArgIf(len(notesRefs) > 0, "--notes").
+		Arg(lo.Map(notesRefs, func(notesRef string, _ int) string { return "--notes=" + notesRef })...).
 		Arg("-p").
 		Arg(hash).
 		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
@@ -265,6 +440,133 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// GetSignatures verifies the signatures of the given commits and returns the
+// results by commit hash. This is slow, because git runs gpg or ssh-keygen for
+// each signed commit.
+func (self *CommitCommands) GetSignatures(hashes []string) (map[string]*models.CommitSignature, error) {
+	cmdArgs := NewGitCmd("log").
+		ConfigIf(self.IsMissingAllowedSignersFile(), "gpg.ssh.allowedSignersFile="+os.DevNull).
+		Arg("--no-walk=unsorted").
+		Arg("--format=%H%x00%G?%x00%GK%x00%GS").
+		Arg("--stdin", "--").
+		ToArgv()
+
+	// There can be lots of hashes, so we pass them on stdin rather than the
+	// command line
+	output, err := self.cmd.New(cmdArgs).SetStdin(strings.Join(hashes, "\n") + "\n").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make(map[string]*models.CommitSignature, len(hashes))
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 4 {
+			continue
+		}
+
+		signatures[fields[0]] = &models.CommitSignature{
+			Status: parseSignatureStatus(fields[1]),
+			Key:    fields[2],
+			Signer: fields[3],
+		}
+	}
+
+	return signatures, nil
+}
+
+func parseSignatureStatus(status string) models.SignatureStatus {
+	switch status {
+	case "G":
+		return models.SignatureGood
+	case "U":
+		return models.SignatureUntrusted
+	case "X", "Y":
+		return models.SignatureExpired
+	case "R":
+		return models.SignatureRevoked
+	case "B":
+		return models.SignatureBad
+	case "E":
+		return models.SignatureUnverifiable
+	default:
+		return models.SignatureNone
+	}
+}
+
+// SignatureVerificationKey returns a string that changes whenever the config
+// or the keys that git verifies signatures with may have changed, so that
+// callers know when to verify signatures again rather than reuse earlier
+// results.
+func (self *CommitCommands) SignatureVerificationKey() string {
+	allowedSignersFile := self.config.GetSshAllowedSignersFile()
+	gpgHome := os.Getenv("GNUPGHOME")
+	if gpgHome == "" {
+		gpgHome = expandHomeDir("~/.gnupg")
+	}
+
+	return strings.Join([]string{
+		strconv.FormatBool(self.config.GetSshSigning()),
+		allowedSignersFile,
+		modificationTime(expandHomeDir(allowedSignersFile)),
+		// gpg replaces its keyring files when keys are added or removed,
+		// which changes the modification time of their directory
+		modificationTime(gpgHome),
+	}, "\x00")
+}
+
+func expandHomeDir(path string) string {
+	if rest, found := strings.CutPrefix(path, "~/"); found {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+
+	return path
+}
+
+func modificationTime(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+
+	return info.ModTime().String()
+}
+
+// Without an allowed signers file, git can't verify SSH signatures at all and
+// reports signed commits as unsigned. When we pass it an empty one, it can at
+// least check the signatures against the keys they were made with, even
+// though it can't tell who the keys belong to.
+func (self *CommitCommands) IsMissingAllowedSignersFile() bool {
+	return self.config.GetSshSigning() && self.config.GetSshAllowedSignersFile() == ""
+}
+
+// ShowLineRangeCmdObj shows how a commit changed the lines that git log -L
+// follows. The line range refers to the file as of refName, and git adjusts it
+// for each commit while walking the history, so we need to walk from refName
+// too, skipping the commits that git log -L lists before the one we want.
+func (self *CommitCommands) ShowLineRangeCmdObj(lineRange string, refName string, skip int) *oscommands.CmdObj {
+	gitLogOrder := self.UserConfig().Git.Log.Order
+
+	cmdArgs := NewGitCmd("log").
+		Config("diff.noprefix=false").
+		Arg(refName).
+		ArgIf(gitLogOrder != "default", "--"+gitLogOrder).
+		Arg("--color="+self.pagerConfig.GetColorArg()).
+		Arg("--decorate").
+		Arg("-L"+lineRange).
+		Arg(fmt.Sprintf("--skip=%d", skip), "-1").
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 func (self *CommitCommands) ShowFileContentCmdObj(hash string, filePath string) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("show").
 		Arg(fmt.Sprintf("%s:%s", hash, filePath)).
@@ -292,6 +594,14 @@ func (self *CommitCommands) CreateFixupCommit(hash string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// CreateFixupCommitFromIndexFile is like CreateFixupCommit, but commits the
+// contents of the given index file rather than those of the repository's index
+func (self *CommitCommands) CreateFixupCommitFromIndexFile(hash string, indexFile string) error {
+	cmdArgs := NewGitCmd("commit").Arg("--fixup=" + hash).ToArgv()
+
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_INDEX_FILE=" + indexFile).Run()
+}
+
 // CreateAmendCommit creates a commit that changes the commit message of a previous commit
 func (self *CommitCommands) CreateAmendCommit(originalSubject, newSubject, newDescription string, includeFileChanges bool) error {
 	description := newSubject
@@ -307,6 +617,28 @@ func (self *CommitCommands) CreateAmendCommit(originalSubject, newSubject, newDe
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// GetRecentCommitMessages returns the messages of the most recent commits
+// across all local branches, newest first, leaving out merge commits and
+// duplicates
+func (self *CommitCommands) GetRecentCommitMessages(limit int) ([]string, error) {
+	cmdArgs := NewGitCmd("log").
+		Config("log.showsignature=false").
+		Arg("--branches", "--no-merges", "--date-order", "-z", "--format=%B").
+		Arg(fmt.Sprintf("--max-count=%d", limit)).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	messages := lo.FilterMap(strings.Split(output, "\x00"), func(message string, _ int) (string, bool) {
+		message = strings.TrimSpace(message)
+		return message, message != ""
+	})
+	return lo.Uniq(messages), nil
+}
+
 // a value of 0 means the head commit, 1 is the parent commit, etc
 func (self *CommitCommands) GetCommitMessageFromHistory(value int) (string, error) {
 	cmdArgs := NewGitCmd("log").Arg("-1", fmt.Sprintf("--skip=%d", value), "--pretty=%H").
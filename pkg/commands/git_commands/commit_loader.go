@@ -56,9 +56,17 @@ func NewCommitLoader(
 }
 
 type GetCommitsOptions struct {
-	Limit                bool
-	FilterPath           string
-	FilterAuthor         string
+	Limit        bool
+	FilterPath   string
+	FilterAuthor string
+	// The argument for git log -L, e.g. "10,20:file" or ":funcname:file"
+	FilterLineRange string
+	// The string that the commits must add or remove (git log -S), or a regex
+	// matching lines that they must add or remove (git log -G)
+	FilterPickaxe        string
+	FilterPickaxeIsRegex bool
+	// The pattern that the commit messages must match (git log --grep)
+	FilterMessage        string
 	IncludeRebaseCommits bool
 	RefName              string     // e.g. "HEAD" or "my_branch"
 	RefForPushedStatus   models.Ref // the ref to use for determining pushed/unpushed status
@@ -68,13 +76,17 @@ type GetCommitsOptions struct {
 	RefToShowDivergenceFrom string
 	MainBranches            *MainBranches
 	HashPool                *utils.StringPool
+	// The notes refs to check for notes annotating the commits; we don't look
+	// for notes if this is empty
+	NotesRefs []string
 }
 
 // GetCommits obtains the commits of the current branch
 func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit, error) {
 	commits := []*models.Commit{}
 
-	if opts.IncludeRebaseCommits && opts.FilterPath == "" {
+	if opts.IncludeRebaseCommits && opts.FilterPath == "" && opts.FilterLineRange == "" &&
+		opts.FilterPickaxe == "" && opts.FilterMessage == "" {
 		var err error
 		commits, err = self.MergeRebasingCommits(opts.HashPool, commits)
 		if err != nil {
@@ -103,6 +115,16 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	var remoteUnmergedCommitHashes *set.Set[string]
 	mainBranches := opts.MainBranches.Get()
 
+	var annotatedCommitHashes *set.Set[string]
+	if len(opts.NotesRefs) > 0 {
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			annotatedCommitHashes = self.getAnnotatedCommitHashes(opts.NotesRefs)
+		})
+	}
+
 	go utils.Safe(func() {
 		defer wg.Done()
 
@@ -149,6 +171,12 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		setCommitStatuses(unpushedCommitHashes, unmergedCommitHashes, commits)
 	}
 
+	if annotatedCommitHashes != nil {
+		for _, commit := range commits {
+			commit.HasNotes = annotatedCommitHashes.Includes(commit.Hash())
+		}
+	}
+
 	return commits, nil
 }
 
@@ -363,13 +391,24 @@ func (self *CommitLoader) getRebasingCommits(hashPool *utils.StringPool, addConf
 	}
 
 	for _, t := range todos {
-		if t.Command == todo.UpdateRef {
+		if t.Commit == "" && !utils.IsRenderedTodoWithoutCommit(t) {
+			// Command does not have a commit associated, and we don't show it; skip
+			continue
+		}
+
+		switch t.Command {
+		case todo.UpdateRef:
 			t.Msg = t.Ref
-		} else if t.Command == todo.Exec {
+		case todo.Exec:
 			t.Msg = t.ExecCommand
-		} else if t.Commit == "" {
-			// Command does not have a commit associated, skip
-			continue
+		case todo.Label, todo.Reset:
+			t.Msg = t.Label
+		case todo.Merge:
+			if t.Commit == "" {
+				// A merge todo that creates a new merge commit; show the label
+				// of the commit that it merges
+				t.Msg = t.Label
+			}
 		}
 		commits = utils.Prepend(commits, models.NewCommit(hashPool, models.NewCommitOpts{
 			Hash:       t.Commit,
@@ -577,6 +616,35 @@ func (self *CommitLoader) getReachableHashes(refName string, notRefNames []strin
 	return set.NewFromSlice(utils.SplitLines(output))
 }
 
+// getAnnotatedCommitHashes returns the hashes of all commits that have a note
+// in any of the given notes refs
+func (self *CommitLoader) getAnnotatedCommitHashes(notesRefs []string) *set.Set[string] {
+	result := set.New[string]()
+	for _, notesRef := range notesRefs {
+		output, _, err := self.cmd.New(
+			NewGitCmd("notes").
+				Arg("--ref=" + notesRef).
+				Arg("list").
+				ToArgv(),
+		).
+			DontLog().
+			RunWithOutputs()
+		if err != nil {
+			// Not worth failing to load the commits for
+			continue
+		}
+
+		// Each line has the form "<note blob hash> <annotated commit hash>"
+		for _, line := range utils.SplitLines(output) {
+			if _, commitHash, found := strings.Cut(line, " "); found {
+				result.Add(commitHash)
+			}
+		}
+	}
+
+	return result
+}
+
 // getLogCmd gets the git log.
 func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 	gitLogOrder := self.UserConfig().Git.Log.Order
@@ -589,13 +657,17 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("log").
 		Arg(refSpec).
 		ArgIf(gitLogOrder != "default", "--"+gitLogOrder).
-		ArgIf(opts.All, "--all").
+		// git log -L can only follow the line range from a single commit
+		ArgIf(opts.All && opts.FilterLineRange == "", "--all").
 		Arg("--oneline").
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
+		ArgIf(opts.FilterMessage != "", "--grep="+opts.FilterMessage).
+		ArgIf(opts.FilterPickaxe != "", lo.Ternary(opts.FilterPickaxeIsRegex, "-G", "-S")+opts.FilterPickaxe).
 		ArgIf(opts.Limit, "-300").
 		ArgIf(opts.FilterPath != "", "--follow", "--name-status").
+		ArgIf(opts.FilterLineRange != "", "-L"+opts.FilterLineRange, "--no-patch").
 		Arg("--no-show-signature").
 		ArgIf(opts.RefToShowDivergenceFrom != "", "--left-right").
 		Arg("--").
@@ -61,6 +61,36 @@ func TestGetCommits(t *testing.T) {
 			expectedCommitOpts: []models.NewCommitOpts{},
 			expectedError:      nil,
 		},
+		{
+			testName: "should mark commits that have notes",
+			logOrder: "topo-order",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, IncludeRebaseCommits: false, NotesRefs: []string{"refs/notes/commits", "refs/notes/review"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
+				// the first notes ref has no note for our commit, the second one does
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "45b983be36b73c0788dc9cbcb76cbb80fc7bb057 b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164\n", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/review", "list"}, "9c1185a5c5e9fc54612808977ee8f548b2258d31 0eea75e8c631fba6b58135697835d58ba4c18dbc\n", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{
+				{
+					Hash:          "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:          "better typing for rebase mode",
+					Status:        models.StatusUnpushed,
+					Action:        models.ActionNone,
+					Tags:          nil,
+					ExtraInfo:     "(HEAD -> better-tests)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826609,
+					Parents: []string{
+						"b21997d6b4cbdf84b149",
+					},
+					HasNotes: true,
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			testName:     "should return commits if they are present",
 			logOrder:     "topo-order",
@@ -294,6 +324,39 @@ func TestGetCommits(t *testing.T) {
 			expectedCommitOpts: []models.NewCommitOpts{},
 			expectedError:      nil,
 		},
+		{
+			testName: "should set filter line range",
+			logOrder: "default",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, FilterLineRange: "10,20:src/file", All: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-L10,20:src/file", "--no-patch", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
+		{
+			testName: "should combine search filters with the path filter",
+			logOrder: "default",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, FilterPath: "src", FilterAuthor: "John", FilterMessage: "fix", FilterPickaxe: "foo()"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--author=John", "--grep=fix", "-Sfoo()", "--follow", "--name-status", "--no-show-signature", "--", "src"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
+		{
+			testName: "should set regex pickaxe",
+			logOrder: "default",
+			opts:     GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: &models.Branch{Name: "mybranch"}, FilterPickaxe: "foo.*bar", FilterPickaxeIsRegex: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-Gfoo.*bar", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -1,8 +1,13 @@
 package git_commands
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -248,6 +253,56 @@ func TestCommitCreateAmendCommit(t *testing.T) {
 	}
 }
 
+func TestCommitGetSignatures(t *testing.T) {
+	type scenario struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		expectedConfigArgs     []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:               "gpg",
+			gitConfigMockResponses: nil,
+			expectedConfigArgs:     []string{},
+		},
+		{
+			testName:               "ssh with allowed signers file",
+			gitConfigMockResponses: map[string]string{"gpg.format": "ssh", "gpg.ssh.allowedSignersFile": "~/.ssh/allowed_signers"},
+			expectedConfigArgs:     []string{},
+		},
+		{
+			testName:               "ssh without allowed signers file",
+			gitConfigMockResponses: map[string]string{"gpg.format": "ssh"},
+			expectedConfigArgs:     []string{"-c", "gpg.ssh.allowedSignersFile=" + os.DevNull},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			output := "1111\x00G\x00ABCD\x00John <john@example.com>\n" +
+				"2222\x00N\x00\x00\n" +
+				"3333\x00Y\x00EF01\x00\n" +
+				"error: some noise\n"
+			args := append(s.expectedConfigArgs, "log", "--no-walk=unsorted", "--format=%H%x00%G?%x00%GK%x00%GS", "--stdin", "--")
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(args, output, nil)
+			instance := buildCommitCommands(commonDeps{
+				runner:    runner,
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+			})
+
+			signatures, err := instance.GetSignatures([]string{"1111", "2222", "3333"})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]*models.CommitSignature{
+				"1111": {Status: models.SignatureGood, Key: "ABCD", Signer: "John <john@example.com>"},
+				"2222": {Status: models.SignatureNone},
+				"3333": {Status: models.SignatureExpired, Key: "EF01"},
+			}, signatures)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitShowCmdObj(t *testing.T) {
 	type scenario struct {
 		testName            string
@@ -256,6 +311,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 		similarityThreshold int
 		ignoreWhitespace    bool
 		pagerConfig         *config.PagingConfig
+		notesRefs           []string
 		expected            []string
 	}
 
@@ -323,6 +379,16 @@ func TestCommitShowCmdObj(t *testing.T) {
 			pagerConfig:         &config.PagingConfig{UseExternalDiffGitConfig: true},
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%", "--"},
 		},
+		{
+			testName:            "Show diff with extra notes refs",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			pagerConfig:         nil,
+			notesRefs:           []string{"review", "refs/notes/commits", "refs/notes/review"},
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--notes", "--notes=refs/notes/review", "-p", "1234567890", "--find-renames=50%", "--"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -334,6 +400,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			userConfig.Git.NotesRefs = s.notesRefs
 
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
 			repoPaths := RepoPaths{
@@ -347,6 +414,18 @@ func TestCommitShowCmdObj(t *testing.T) {
 	}
 }
 
+func TestCommitShowLineRangeCmdObj(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "log", "HEAD", "--topo-order", "--color=always", "--decorate", "-L:main:src/main.go", "--skip=2", "-1"}, "", nil)
+	repoPaths := RepoPaths{
+		worktreePath: "/path/to/worktree",
+	}
+	instance := buildCommitCommands(commonDeps{runner: runner, repoPaths: &repoPaths})
+
+	assert.NoError(t, instance.ShowLineRangeCmdObj(":main:src/main.go", "HEAD", 2).Run())
+	runner.CheckForMissingCalls()
+}
+
 func TestGetCommitMsg(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -425,6 +504,18 @@ func TestGetCommitMessageFromHistory(t *testing.T) {
 	}
 }
 
+func TestGetRecentCommitMessages(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-c", "log.showsignature=false", "log", "--branches", "--no-merges", "--date-order", "-z", "--format=%B", "--max-count=3"},
+			"Add feature\n\nSome description\n\x00Fix typo\n\x00Add feature\n\nSome description\n\x00", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	messages, err := instance.GetRecentCommitMessages(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Add feature\n\nSome description", "Fix typo"}, messages)
+	runner.CheckForMissingCalls()
+}
+
 func TestAddCoAuthorToMessage(t *testing.T) {
 	scenarios := []struct {
 		name           string
@@ -491,3 +582,147 @@ func TestAddCoAuthorToDescription(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitGetTrailers(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"interpret-trailers", "--parse", "--no-divider"},
+			"Signed-off-by: John Doe <john@doe.com>\nRefs: ABC-123\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	trailers, err := instance.GetTrailers("Subject\n\nSigned-off-by: John Doe <john@doe.com>\nRefs: ABC-123")
+	assert.NoError(t, err)
+	assert.Equal(t, []Trailer{
+		{Key: "Signed-off-by", Value: "John Doe <john@doe.com>"},
+		{Key: "Refs", Value: "ABC-123"},
+	}, trailers)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitSetTrailers(t *testing.T) {
+	type scenario struct {
+		testName       string
+		runner         *oscommands.FakeCmdObjRunner
+		message        string
+		trailers       []Trailer
+		expectedResult string
+	}
+	scenarios := []scenario{
+		{
+			testName: "Add trailers",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"interpret-trailers", "--parse", "--no-divider"}, "", nil).
+				ExpectGitArgs([]string{"interpret-trailers", "--no-divider", "--where", "end", "--if-exists", "add", "--if-missing", "add", "--trailer=Refs: ABC-123"},
+					"Subject\n\nRefs: ABC-123\n", nil),
+			message:        "Subject",
+			trailers:       []Trailer{{Key: "Refs", Value: "ABC-123"}},
+			expectedResult: "Subject\n\nRefs: ABC-123",
+		},
+		{
+			testName: "Remove all trailers",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"interpret-trailers", "--parse", "--no-divider"}, "Refs: ABC-123\n", nil),
+			message:        "Subject\n\nBody\n\nRefs: ABC-123",
+			trailers:       []Trailer{},
+			expectedResult: "Subject\n\nBody",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+
+			result, err := instance.SetTrailers(s.message, s.trailers)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedResult, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRemoveTrailerLines(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		message        string
+		trailers       []Trailer
+		expectedResult string
+	}{
+		{
+			name:           "Just a subject",
+			message:        "Subject: with a colon",
+			trailers:       []Trailer{},
+			expectedResult: "Subject: with a colon",
+		},
+		{
+			name:    "Only trailers after the subject",
+			message: "Subject\n\nSigned-off-by: John Doe <john@doe.com>\nRefs: ABC-123",
+			trailers: []Trailer{
+				{Key: "Signed-off-by", Value: "John Doe <john@doe.com>"},
+				{Key: "Refs", Value: "ABC-123"},
+			},
+			expectedResult: "Subject",
+		},
+		{
+			name:           "Body paragraphs are kept",
+			message:        "Subject\n\nNote: this is kept\n\nRefs: ABC-123",
+			trailers:       []Trailer{{Key: "Refs", Value: "ABC-123"}},
+			expectedResult: "Subject\n\nNote: this is kept",
+		},
+		{
+			name:    "Continuation lines are removed",
+			message: "Subject\n\nBody\n\nRefs: ABC-123\n  ABC-456\nAcked-by: Jane Smith <jane@smith.com>",
+			trailers: []Trailer{
+				{Key: "Refs", Value: "ABC-123 ABC-456"},
+				{Key: "Acked-by", Value: "Jane Smith <jane@smith.com>"},
+			},
+			expectedResult: "Subject\n\nBody",
+		},
+		{
+			name:           "Other lines in the trailer block are kept",
+			message:        "Subject\n\nBody\n\n(cherry picked from commit 1234567)\nSigned-off-by: John Doe <john@doe.com>",
+			trailers:       []Trailer{{Key: "Signed-off-by", Value: "John Doe <john@doe.com>"}},
+			expectedResult: "Subject\n\nBody\n\n(cherry picked from commit 1234567)",
+		},
+		{
+			name:           "Lines that look like trailers but weren't parsed as such are kept",
+			message:        "Subject\n\nNote: this is not a trailer\nSigned-off-by: John Doe <john@doe.com>",
+			trailers:       []Trailer{{Key: "Signed-off-by", Value: "John Doe <john@doe.com>"}},
+			expectedResult: "Subject\n\nNote: this is not a trailer",
+		},
+		{
+			name:    "Duplicate trailers are removed as often as they were parsed",
+			message: "Subject\n\nRefs: ABC-123\nRefs: ABC-123",
+			trailers: []Trailer{
+				{Key: "Refs", Value: "ABC-123"},
+			},
+			expectedResult: "Subject\n\nRefs: ABC-123",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, RemoveTrailerLines(s.message, s.trailers))
+		})
+	}
+}
+
+func TestCommitSignatureVerificationKey(t *testing.T) {
+	allowedSignersFile := filepath.Join(t.TempDir(), "allowed_signers")
+	assert.NoError(t, os.WriteFile(allowedSignersFile, []byte("john@example.com ssh-ed25519 AAAA\n"), 0o644))
+
+	buildInstance := func(gitConfigMockResponses map[string]string) *CommitCommands {
+		return buildCommitCommands(commonDeps{
+			gitConfig: git_config.NewFakeGitConfig(gitConfigMockResponses),
+		})
+	}
+
+	sshConfig := map[string]string{"gpg.format": "ssh", "gpg.ssh.allowedSignersFile": allowedSignersFile}
+	key := buildInstance(sshConfig).SignatureVerificationKey()
+	assert.Equal(t, key, buildInstance(sshConfig).SignatureVerificationKey())
+
+	// the user changed their config
+	assert.NotEqual(t, key, buildInstance(nil).SignatureVerificationKey())
+	assert.NotEqual(t, key, buildInstance(map[string]string{"gpg.format": "ssh"}).SignatureVerificationKey())
+
+	// the user added a signer
+	later := time.Now().Add(time.Hour)
+	assert.NoError(t, os.Chtimes(allowedSignersFile, later, later))
+	assert.NotEqual(t, key, buildInstance(sshConfig).SignatureVerificationKey())
+}
@@ -0,0 +1,159 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+type CommitTestingCommands struct {
+	*GitCommon
+}
+
+func NewCommitTestingCommands(gitCommon *GitCommon) *CommitTestingCommands {
+	return &CommitTestingCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// The results are stored in the repo's git dir so that they are shared between
+// its worktrees and go away together with the repo
+func (self *CommitTestingCommands) resultsPath() string {
+	return filepath.Join(self.repoPaths.RepoGitDirPath(), "lazygit", "commit-tests.yml")
+}
+
+// GetTreeHashes returns the tree hash of each of the given commits. Commits
+// that don't exist are left out.
+func (self *CommitTestingCommands) GetTreeHashes(hashes []string) (map[string]string, error) {
+	cmdArgs := NewGitCmd("cat-file").
+		Arg("--batch-check=%(objectname)").
+		ToArgv()
+
+	// There can be lots of hashes, so we pass them on stdin rather than the
+	// command line
+	stdin := strings.Join(lo.Map(hashes, func(hash string, _ int) string { return hash + "^{tree}" }), "\n") + "\n"
+	output, err := self.cmd.New(cmdArgs).SetStdin(stdin).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// There is one line per hash, which is "<hash>^{tree} missing" for
+	// commits that don't exist
+	lines := utils.SplitLines(output)
+	if len(lines) != len(hashes) {
+		return nil, errors.New("unexpected output from git cat-file: " + output)
+	}
+
+	result := make(map[string]string, len(hashes))
+	for i, hash := range hashes {
+		if treeHash := strings.TrimSpace(lines[i]); !strings.HasSuffix(treeHash, " missing") {
+			result[hash] = treeHash
+		}
+	}
+	return result, nil
+}
+
+// RunCmdObj runs the test command in the given worktree
+func (self *CommitTestingCommands) RunCmdObj(command string, worktreePath string) *oscommands.CmdObj {
+	return self.cmd.NewShell(command, self.UserConfig().OS.ShellFunctionsFile).SetWd(worktreePath)
+}
+
+func (self *CommitTestingCommands) LoadResults() (*models.CommitTestResults, error) {
+	results := &models.CommitTestResults{}
+
+	content, err := afero.ReadFile(self.Fs, self.resultsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return results, nil
+		}
+		return results, err
+	}
+
+	if err := yaml.Unmarshal(content, results); err != nil {
+		return &models.CommitTestResults{}, err
+	}
+
+	return results, nil
+}
+
+// We only keep the end of a test's output, which is where the reason for a
+// failure usually is, and only the most recent results, so that the results
+// file doesn't grow too big
+const (
+	maxSavedCommitTestOutputBytes = 16 * 1024
+	maxSavedCommitTestResults     = 500
+)
+
+// SaveResults stores the most recent of the given results, except for tests
+// that are still running, with their output truncated to its last lines
+func (self *CommitTestingCommands) SaveResults(results *models.CommitTestResults) error {
+	type entry struct {
+		command  string
+		treeHash string
+		result   *models.CommitTestResult
+	}
+	entries := []entry{}
+	for command, resultsByTree := range results.Results {
+		for treeHash, result := range resultsByTree {
+			if result.Status != models.CommitTestRunning {
+				entries = append(entries, entry{command, treeHash, result})
+			}
+		}
+	}
+	if len(entries) > maxSavedCommitTestResults {
+		slices.SortFunc(entries, func(a, b entry) int {
+			return b.result.TestedAt.Compare(a.result.TestedAt)
+		})
+		entries = entries[:maxSavedCommitTestResults]
+	}
+
+	finished := &models.CommitTestResults{
+		LastCommand: results.LastCommand,
+		Results:     map[string]map[string]*models.CommitTestResult{},
+	}
+	for _, entry := range entries {
+		savedResult := *entry.result
+		savedResult.Output = tailOfTestOutput(entry.result.Output, maxSavedCommitTestOutputBytes)
+		finished.ForCommand(entry.command)[entry.treeHash] = &savedResult
+	}
+
+	content, err := yaml.Marshal(finished)
+	if err != nil {
+		return err
+	}
+
+	if err := self.Fs.MkdirAll(filepath.Dir(self.resultsPath()), 0o755); err != nil {
+		return err
+	}
+
+	return afero.WriteFile(self.Fs, self.resultsPath(), content, 0o644)
+}
+
+// Returns the last lines of the given output that fit into maxBytes, preceded
+// by a marker if lines were dropped
+func tailOfTestOutput(output string, maxBytes int) string {
+	if len(output) <= maxBytes {
+		return output
+	}
+
+	tail := output[len(output)-maxBytes:]
+	if idx := strings.IndexByte(tail, '\n'); idx != -1 {
+		tail = tail[idx+1:]
+	} else {
+		// Don't start in the middle of a character
+		for len(tail) > 0 && !utf8.RuneStart(tail[0]) {
+			tail = tail[1:]
+		}
+	}
+	return "[...]\n" + tail
+}
@@ -0,0 +1,103 @@
+package git_commands
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitTestingGetTreeHashes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"cat-file", "--batch-check=%(objectname)"}, "111\nccc^{tree} missing\n222\n", nil)
+	instance := buildCommitTestingCommands(commonDeps{runner: runner})
+
+	treeHashes, err := instance.GetTreeHashes([]string{"aaa", "ccc", "bbb"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"aaa": "111", "bbb": "222"}, treeHashes)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitTestingResults(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	instance := buildCommitTestingCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+	results, err := instance.LoadResults()
+	assert.NoError(t, err)
+	assert.Empty(t, results.Results)
+
+	testedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	results.LastCommand = "make test"
+	results.ForCommand("make test")["111"] = &models.CommitTestResult{Status: models.CommitTestPassed, TestedAt: testedAt}
+	results.ForCommand("make test")["222"] = &models.CommitTestResult{Status: models.CommitTestFailed, Output: "boom\n", TestedAt: testedAt}
+	results.ForCommand("make test")["333"] = &models.CommitTestResult{Status: models.CommitTestRunning}
+	assert.NoError(t, instance.SaveResults(results))
+
+	exists, _ := afero.Exists(fs, "/repo/.git/lazygit/commit-tests.yml")
+	assert.True(t, exists)
+
+	loaded, err := instance.LoadResults()
+	assert.NoError(t, err)
+	assert.Equal(t, "make test", loaded.LastCommand)
+	assert.Equal(t, map[string]*models.CommitTestResult{
+		"111": {Status: models.CommitTestPassed, TestedAt: testedAt},
+		"222": {Status: models.CommitTestFailed, Output: "boom\n", TestedAt: testedAt},
+	}, loaded.ForCommand("make test"))
+}
+
+func TestCommitTestingSaveResultsTruncatesOutput(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	instance := buildCommitTestingCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+	longOutput := strings.Repeat("some line\n", 2000) + "the error\n"
+	results := &models.CommitTestResults{LastCommand: "make test"}
+	results.ForCommand("make test")["111"] = &models.CommitTestResult{Status: models.CommitTestFailed, Output: longOutput}
+	assert.NoError(t, instance.SaveResults(results))
+
+	// The results in memory are left alone
+	assert.Equal(t, longOutput, results.ForCommand("make test")["111"].Output)
+
+	loaded, err := instance.LoadResults()
+	assert.NoError(t, err)
+	output := loaded.ForCommand("make test")["111"].Output
+	assert.LessOrEqual(t, len(output), maxSavedCommitTestOutputBytes+len("[...]\n"))
+	assert.True(t, strings.HasPrefix(output, "[...]\nsome line\n"))
+	assert.True(t, strings.HasSuffix(output, "some line\nthe error\n"))
+}
+
+func TestTailOfTestOutput(t *testing.T) {
+	assert.Equal(t, "short\n", tailOfTestOutput("short\n", 10))
+	assert.Equal(t, "[...]\nthree\n", tailOfTestOutput("one\ntwo\nthree\n", 10))
+	assert.Equal(t, "[...]\nüü", tailOfTestOutput("üüü", 5))
+}
+
+func TestCommitTestingSaveResultsKeepsMostRecent(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	instance := buildCommitTestingCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := &models.CommitTestResults{LastCommand: "make test"}
+	for i := range maxSavedCommitTestResults + 10 {
+		command := lo.Ternary(i%2 == 0, "make test", "make lint")
+		results.ForCommand(command)[fmt.Sprintf("tree%d", i)] = &models.CommitTestResult{
+			Status:   models.CommitTestPassed,
+			TestedAt: start.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	assert.NoError(t, instance.SaveResults(results))
+
+	loaded, err := instance.LoadResults()
+	assert.NoError(t, err)
+	assert.Len(t, loaded.ForCommand("make test"), maxSavedCommitTestResults/2)
+	assert.Len(t, loaded.ForCommand("make lint"), maxSavedCommitTestResults/2)
+	// The oldest ones were dropped
+	assert.NotContains(t, loaded.ForCommand("make test"), "tree0")
+	assert.NotContains(t, loaded.ForCommand("make lint"), "tree9")
+	assert.Contains(t, loaded.ForCommand("make lint"), "tree11")
+}
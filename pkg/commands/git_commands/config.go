@@ -62,6 +62,49 @@ func (self *ConfigCommands) GetRemoteURL() string {
 	return self.gitConfig.Get("remote.origin.url")
 }
 
+// GetCommitTemplate returns the path of the commit message template file
+// configured in git's commit.template, or an empty string if there is none
+func (self *ConfigCommands) GetCommitTemplate() string {
+	return self.gitConfig.Get("commit.template")
+}
+
+// GetHooksPath returns the hooks directory configured in core.hooksPath, or an
+// empty string if the default one is used
+func (self *ConfigCommands) GetHooksPath() string {
+	return self.gitConfig.Get("core.hooksPath")
+}
+
+// GetNotesRef returns the notes ref configured in core.notesRef, or an empty
+// string if the default one is used
+func (self *ConfigCommands) GetNotesRef() string {
+	return self.gitConfig.Get("core.notesRef")
+}
+
+func (self *ConfigCommands) GetSparseCheckout() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout")
+}
+
+func (self *ConfigCommands) GetSparseCheckoutCone() bool {
+	return self.gitConfig.GetBool("core.sparseCheckoutCone")
+}
+
+// GetRerereEnabled returns the value of rerere.enabled. The second return value
+// is false if it isn't set, in which case git enables rerere if the rr-cache
+// directory exists.
+func (self *ConfigCommands) GetRerereEnabled() (bool, bool) {
+	return self.gitConfig.GetBool("rerere.enabled"), self.gitConfig.Get("rerere.enabled") != ""
+}
+
+// Whether commits are signed with SSH keys rather than GPG keys
+func (self *ConfigCommands) GetSshSigning() bool {
+	return self.gitConfig.Get("gpg.format") == "ssh"
+}
+
+// The file that lists whose SSH keys git trusts when verifying signatures
+func (self *ConfigCommands) GetSshAllowedSignersFile() string {
+	return self.gitConfig.Get("gpg.ssh.allowedSignersFile")
+}
+
 func (self *ConfigCommands) GetShowUntrackedFiles() string {
 	return self.gitConfig.Get("status.showUntrackedFiles")
 }
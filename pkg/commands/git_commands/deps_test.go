@@ -120,6 +120,63 @@ func buildCommitCommands(deps commonDeps) *CommitCommands {
 	return NewCommitCommands(gitCommon)
 }
 
+func buildBlameCommands(deps commonDeps) *BlameCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewBlameCommands(gitCommon)
+}
+
+func buildNotesCommands(deps commonDeps) *NotesCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewNotesCommands(gitCommon)
+}
+
+func buildLfsCommands(deps commonDeps) *LfsCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewLfsCommands(gitCommon)
+}
+
+func buildSparseCheckoutCommands(deps commonDeps) *SparseCheckoutCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewSparseCheckoutCommands(gitCommon)
+}
+
+func buildRangeDiffCommands(deps commonDeps) *RangeDiffCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewRangeDiffCommands(gitCommon)
+}
+
+func buildPatchSeriesCommands(deps commonDeps) *PatchSeriesCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewPatchSeriesCommands(gitCommon)
+}
+
+func buildRerereCommands(deps commonDeps) *RerereCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewRerereCommands(gitCommon)
+}
+
+func buildMaintenanceCommands(deps commonDeps) *MaintenanceCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewMaintenanceCommands(gitCommon)
+}
+
+func buildCommitTestingCommands(deps commonDeps) *CommitTestingCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewCommitTestingCommands(gitCommon)
+}
+
+func buildBranchStackCommands(deps commonDeps) *BranchStackCommands {
+	gitCommon := buildGitCommon(deps)
+	branchCommands := buildBranchCommands(deps)
+	branchLoader := NewBranchLoader(gitCommon.Common, gitCommon, gitCommon.cmd, branchCommands.CurrentBranchInfo, gitCommon.config)
+	return NewBranchStackCommands(gitCommon, branchLoader)
+}
+
+func buildBisectCommands(deps commonDeps) *BisectCommands {
+	gitCommon := buildGitCommon(deps)
+	return NewBisectCommands(gitCommon)
+}
+
 func buildWorkingTreeCommands(deps commonDeps) *WorkingTreeCommands {
 	gitCommon := buildGitCommon(deps)
 	submoduleCommands := buildSubmoduleCommands(deps)
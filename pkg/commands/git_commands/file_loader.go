@@ -6,12 +6,15 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
 	GetShowUntrackedFiles() string
+	GetSparseCheckout() bool
 }
 
 type FileLoader struct {
@@ -102,9 +105,70 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	if self.config.GetSparseCheckout() {
+		self.markSkipWorktreeFiles(files)
+	}
+
 	return files
 }
 
+// In a sparse checkout, a file that is outside of the checked out directories
+// can still show up in the status, e.g. because it has staged changes. We mark
+// these files so that users understand why they are missing in the working
+// tree.
+func (self *FileLoader) markSkipWorktreeFiles(files []*models.File) {
+	trackedFiles := lo.Filter(files, func(file *models.File, _ int) bool { return file.Tracked })
+	if len(trackedFiles) == 0 {
+		return
+	}
+
+	// We list the whole index rather than passing the paths to ls-files, so
+	// that we don't have to worry about them being interpreted as patterns or
+	// exceeding the maximum command line length
+	indexFiles, err := self.GetTrackedFiles()
+	if err != nil {
+		self.Log.Error(err)
+		return
+	}
+
+	skipWorktreePaths := set.NewFromSlice(lo.FilterMap(indexFiles, func(file *models.File, _ int) (string, bool) {
+		return file.Path, file.IsSkipWorktree
+	}))
+	for _, file := range trackedFiles {
+		file.IsSkipWorktree = skipWorktreePaths.Includes(file.Path)
+	}
+}
+
+// GetTrackedFiles returns all files in the index, with IsSkipWorktree set for
+// those that aren't checked out in the working tree
+func (self *FileLoader) GetTrackedFiles() ([]*models.File, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("-z", "-t").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*models.File{}
+	for _, entry := range strings.Split(output, "\x00") {
+		// Each entry is a status tag, a space, and the path
+		if len(entry) < 3 {
+			continue
+		}
+
+		files = append(files, &models.File{
+			Path:           entry[2:],
+			Tracked:        true,
+			ShortStatus:    "  ",
+			IsSkipWorktree: entry[0] == 'S',
+		})
+	}
+
+	return files, nil
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
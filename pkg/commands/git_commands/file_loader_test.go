@@ -6,6 +6,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -253,8 +254,52 @@ func TestFileGetStatusFiles(t *testing.T) {
 
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
+	sparseCheckout     bool
 }
 
 func (self *FakeFileLoaderConfig) GetShowUntrackedFiles() string {
 	return self.showUntrackedFiles
 }
+
+func (self *FakeFileLoaderConfig) GetSparseCheckout() bool {
+	return self.sparseCheckout
+}
+
+func TestFileLoaderGetTrackedFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-files", "-z", "-t"}, "H top\x00H lib/core/file1\x00S lib/extra/file2\x00", nil)
+	gitCommon := buildGitCommon(commonDeps{runner: runner})
+	loader := buildFileLoader(gitCommon)
+
+	files, err := loader.GetTrackedFiles()
+	assert.NoError(t, err)
+	assert.EqualValues(t, []*models.File{
+		{Path: "top", Tracked: true, ShortStatus: "  "},
+		{Path: "lib/core/file1", Tracked: true, ShortStatus: "  "},
+		{Path: "lib/extra/file2", Tracked: true, ShortStatus: "  ", IsSkipWorktree: true},
+	}, files)
+	runner.CheckForMissingCalls()
+}
+
+func TestFileGetStatusFilesInSparseCheckout(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+			"M  dir/file[1]\x00 M top\x00?? untracked", nil).
+		ExpectGitArgs([]string{"ls-files", "-z", "-t"}, "S dir/file1\x00S dir/file[1]\x00H other\x00H top\x00", nil)
+
+	userConfig := &config.UserConfig{}
+	userConfig.Git.RenameSimilarityThreshold = 50
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes", sparseCheckout: true},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{})
+	assert.Equal(t, []bool{true, false, false}, lo.Map(files, func(file *models.File, _ int) bool {
+		return file.IsSkipWorktree
+	}))
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,165 @@
+package git_commands
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type HookCommands struct {
+	*GitCommon
+}
+
+func NewHookCommands(gitCommon *GitCommon) *HookCommands {
+	return &HookCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// The kind of pre-commit hooks that a repo has
+type PreCommitHooksKind int
+
+const (
+	NoPreCommitHooks PreCommitHooksKind = iota
+	// Hooks managed by the pre-commit framework (https://pre-commit.com),
+	// configured in .pre-commit-config.yaml
+	PreCommitFrameworkHooks
+	// An executable pre-commit script in the hooks directory, i.e. the one
+	// configured in core.hooksPath or .git/hooks
+	PreCommitScriptHook
+)
+
+type HookStatus int
+
+const (
+	HookPassed HookStatus = iota
+	HookFailed
+	HookSkipped
+)
+
+type HookResult struct {
+	Name   string
+	Status HookStatus
+	// What the hook printed; for hooks run by the pre-commit framework this is
+	// only available for failed hooks
+	Output string
+}
+
+const preCommitFrameworkConfigFile = ".pre-commit-config.yaml"
+
+// DetectPreCommitHooks tells us which kind of pre-commit hooks the repo has.
+// The pre-commit framework wins over a plain script because it installs a
+// script of its own, and because it can tell us the result of each hook.
+func (self *HookCommands) DetectPreCommitHooks() PreCommitHooksKind {
+	if _, err := os.Stat(filepath.Join(self.repoPaths.WorktreePath(), preCommitFrameworkConfigFile)); err == nil {
+		return PreCommitFrameworkHooks
+	}
+
+	info, err := os.Stat(self.preCommitScriptPath())
+	if err == nil && !info.IsDir() && info.Mode()&0o111 != 0 {
+		return PreCommitScriptHook
+	}
+
+	return NoPreCommitHooks
+}
+
+// IsPreCommitFrameworkInstalled tells us whether the pre-commit executable,
+// which we need for running hooks managed by the pre-commit framework, can be
+// found
+func (self *HookCommands) IsPreCommitFrameworkInstalled() bool {
+	_, err := exec.LookPath("pre-commit")
+	return err == nil
+}
+
+// RunPreCommitHooks runs the repo's pre-commit hooks against the staged files,
+// the same way that git would when committing. An error is only returned if
+// the hooks couldn't be run at all; failing hooks are reported in the
+// results.
+func (self *HookCommands) RunPreCommitHooks(kind PreCommitHooksKind) ([]HookResult, error) {
+	switch kind {
+	case PreCommitFrameworkHooks:
+		cmdArgs := []string{"pre-commit", "run", "--color", "never"}
+		output, err := self.cmd.New(cmdArgs).SetWd(self.repoPaths.WorktreePath()).RunWithOutput()
+		results := ParsePreCommitFrameworkOutput(output)
+		if len(results) == 0 && err != nil {
+			return nil, err
+		}
+		return results, nil
+	case PreCommitScriptHook:
+		output, err := self.cmd.New([]string{self.preCommitScriptPath()}).
+			SetWd(self.repoPaths.WorktreePath()).RunWithOutput()
+		status := HookPassed
+		if err != nil {
+			status = HookFailed
+		}
+		return []HookResult{{Name: "pre-commit", Status: status, Output: strings.TrimSpace(output)}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (self *HookCommands) preCommitScriptPath() string {
+	hooksPath := self.config.GetHooksPath()
+	if hooksPath == "" {
+		return filepath.Join(self.repoPaths.RepoGitDirPath(), "hooks", "pre-commit")
+	}
+
+	if rest, ok := strings.CutPrefix(hooksPath, "~/"); ok {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			hooksPath = filepath.Join(homeDir, rest)
+		}
+	} else if !filepath.IsAbs(hooksPath) {
+		// git resolves a relative hooks path against the root of the worktree
+		hooksPath = filepath.Join(self.repoPaths.WorktreePath(), hooksPath)
+	}
+	return filepath.Join(hooksPath, "pre-commit")
+}
+
+// Matches the result lines of `pre-commit run`, e.g.
+//
+//	trim trailing whitespace.................................................Passed
+//	check yaml...........................................(no files to check)Skipped
+var preCommitResultRegexp = regexp.MustCompile(`^(.+?)\.{3,}(?:\(.*\))?(Passed|Failed|Skipped)$`)
+
+// ParsePreCommitFrameworkOutput extracts the per-hook results from the output
+// of `pre-commit run`. Any lines following a result line, up to the next
+// result line, are taken to be that hook's output, except for pre-commit's own
+// informational messages.
+func ParsePreCommitFrameworkOutput(output string) []HookResult {
+	results := []HookResult{}
+	var outputLines []string
+
+	finishResult := func() {
+		if len(results) > 0 {
+			results[len(results)-1].Output = strings.TrimSpace(strings.Join(outputLines, "\n"))
+		}
+		outputLines = nil
+	}
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if strings.HasPrefix(line, "[INFO] ") {
+			continue
+		}
+
+		match := preCommitResultRegexp.FindStringSubmatch(strings.TrimRight(line, " \r"))
+		if match == nil {
+			outputLines = append(outputLines, line)
+			continue
+		}
+
+		finishResult()
+		status := HookPassed
+		switch match[2] {
+		case "Failed":
+			status = HookFailed
+		case "Skipped":
+			status = HookSkipped
+		}
+		results = append(results, HookResult{Name: strings.TrimSpace(match[1]), Status: status})
+	}
+	finishResult()
+
+	return results
+}
@@ -0,0 +1,56 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePreCommitFrameworkOutput(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		output          string
+		expectedResults []HookResult
+	}{
+		{
+			name:            "no output",
+			output:          "",
+			expectedResults: []HookResult{},
+		},
+		{
+			name: "passed, failed and skipped hooks",
+			output: `[INFO] Stashing unstaged files to /home/user/.cache/pre-commit/patch1700000000-1234.
+trim trailing whitespace.................................................Passed
+fix end of files.........................................................Failed
+- hook id: end-of-file-fixer
+- exit code: 1
+- files were modified by this hook
+
+Fixing file.txt
+
+check yaml...........................................(no files to check)Skipped
+[INFO] Restored changes from /home/user/.cache/pre-commit/patch1700000000-1234.
+`,
+			expectedResults: []HookResult{
+				{Name: "trim trailing whitespace", Status: HookPassed},
+				{
+					Name:   "fix end of files",
+					Status: HookFailed,
+					Output: "- hook id: end-of-file-fixer\n- exit code: 1\n- files were modified by this hook\n\nFixing file.txt",
+				},
+				{Name: "check yaml", Status: HookSkipped},
+			},
+		},
+		{
+			name:            "not a pre-commit result",
+			output:          "An unexpected error has occurred: CalledProcessError: command: ('/usr/bin/git', 'diff')",
+			expectedResults: []HookResult{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResults, ParsePreCommitFrameworkOutput(s.output))
+		})
+	}
+}
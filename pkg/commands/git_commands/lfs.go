@@ -0,0 +1,298 @@
+package git_commands
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+const (
+	// Pass as a version to GetPointer to get the pointer of the file in the
+	// working tree
+	LfsWorkingTreeVersion = ""
+	// Pass as a version to GetPointer to get the pointer of the file in the
+	// index
+	LfsIndexVersion = ":"
+
+	lfsPointerVersionLine = "version https://git-lfs.github.com/spec/v1"
+	// Pointer files are tiny, and git-lfs doesn't accept bigger ones either
+	lfsMaxPointerSize = 1024
+)
+
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// LfsPointer describes an LFS object, as stored in the pointer file that git
+// tracks instead of the object itself
+type LfsPointer struct {
+	Oid  string // e.g. "sha256:4d7a2146..."
+	Size int64
+	// True if the file isn't a pointer file but has its content stored in git
+	// directly, e.g. because it was committed before it was tracked by LFS, or
+	// because git-lfs isn't installed. Oid and Size are then what the pointer
+	// would be, except that Oid is empty for content in git that is too big to
+	// be a pointer, since we don't read that.
+	NotAPointer bool
+}
+
+// ParseLfsPointer parses the content of an LFS pointer file, as described in
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md. Returns false if
+// the content isn't a pointer.
+func ParseLfsPointer(content string) (*LfsPointer, bool) {
+	if len(content) > lfsMaxPointerSize {
+		return nil, false
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if len(lines) < 3 || lines[0] != lfsPointerVersionLine {
+		return nil, false
+	}
+
+	pointer := &LfsPointer{}
+	for _, line := range lines[1:] {
+		key, value, found := strings.Cut(line, " ")
+		if !found {
+			return nil, false
+		}
+
+		switch key {
+		case "oid":
+			pointer.Oid = value
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			pointer.Size = size
+		}
+	}
+
+	if pointer.Oid == "" {
+		return nil, false
+	}
+
+	return pointer, true
+}
+
+// Returns the pointer that git-lfs would create for the given content
+func lfsPointerForContent(content io.Reader) (*LfsPointer, error) {
+	hash := sha256.New()
+	size, err := io.Copy(hash, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LfsPointer{
+		Oid:         "sha256:" + hex.EncodeToString(hash.Sum(nil)),
+		Size:        size,
+		NotAPointer: true,
+	}, nil
+}
+
+// UsesLfs returns true if the repo's attributes tell git to store any files in
+// LFS. We only check the top-level .gitattributes file and the repo's
+// info/attributes file, so that we don't have to look for attributes files
+// everywhere in the working tree.
+func (self *LfsCommands) UsesLfs() bool {
+	attributesFiles := []string{
+		filepath.Join(self.repoPaths.WorktreePath(), ".gitattributes"),
+		filepath.Join(self.repoPaths.RepoGitDirPath(), "info", "attributes"),
+	}
+
+	return lo.SomeBy(attributesFiles, func(path string) bool {
+		content, err := os.ReadFile(path)
+		return err == nil && strings.Contains(string(content), "filter=lfs")
+	})
+}
+
+// TrackedPaths returns those of the given paths that are tracked by LFS
+// according to the repo's attributes files
+func (self *LfsCommands) TrackedPaths(paths []string) *set.Set[string] {
+	result := set.New[string]()
+	if len(paths) == 0 || !self.UsesLfs() {
+		return result
+	}
+
+	cmdArgs := NewGitCmd("check-attr").
+		Arg("-z", "--stdin", "filter").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).
+		SetStdin(strings.Join(paths, "\x00") + "\x00").
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		self.Log.Error(err)
+		return result
+	}
+
+	// The output consists of NUL-terminated triples of path, attribute, and
+	// value
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result.Add(fields[i])
+		}
+	}
+
+	return result
+}
+
+// GetPointer returns the LFS pointer of the file at the given path in the
+// given version, which is either a ref, LfsIndexVersion, or
+// LfsWorkingTreeVersion. Returns nil if the file doesn't exist in that version.
+func (self *LfsCommands) GetPointer(version string, path string) (*LfsPointer, error) {
+	if version == LfsWorkingTreeVersion {
+		return self.getWorkingTreePointer(path)
+	}
+
+	objectName := strings.TrimSuffix(version, ":") + ":" + path
+	cmdArgs := NewGitCmd("cat-file").
+		Arg("-s", objectName).
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		// The file doesn't exist in this version
+		return nil, nil
+	}
+
+	size, err := strconv.ParseInt(strings.TrimSpace(output), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if size > lfsMaxPointerSize {
+		// Too big to be a pointer, and possibly huge, so we don't read it
+		return &LfsPointer{Size: size, NotAPointer: true}, nil
+	}
+
+	cmdArgs = NewGitCmd("cat-file").
+		Arg("blob", objectName).
+		ToArgv()
+	content, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	if pointer, ok := ParseLfsPointer(content); ok {
+		return pointer, nil
+	}
+
+	return lfsPointerForContent(strings.NewReader(content))
+}
+
+func (self *LfsCommands) getWorkingTreePointer(path string) (*LfsPointer, error) {
+	file, err := os.Open(filepath.Join(self.repoPaths.WorktreePath(), path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	// Peek fails with io.EOF for files smaller than the pointer size limit,
+	// which is exactly the ones that can be pointers
+	start, err := reader.Peek(lfsMaxPointerSize + 1)
+	if errors.Is(err, io.EOF) {
+		if pointer, ok := ParseLfsPointer(string(start)); ok {
+			return pointer, nil
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return lfsPointerForContent(reader)
+}
+
+func (self *LfsCommands) GetLocks(task gocui.Task) ([]*models.LfsLock, error) {
+	cmdArgs := NewGitCmd("lfs").
+		Arg("locks", "--json").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseLfsLocks(output)
+}
+
+// The format of a lock in the output of `git lfs locks --json`
+type lfsLockJSON struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+	LockedAt string `json:"locked_at"`
+}
+
+// ParseLfsLocks parses the output of `git lfs locks --json`
+func ParseLfsLocks(output string) ([]*models.LfsLock, error) {
+	var locks []lfsLockJSON
+	if err := json.Unmarshal([]byte(output), &locks); err != nil {
+		return nil, err
+	}
+
+	return lo.Map(locks, func(lock lfsLockJSON, _ int) *models.LfsLock {
+		return &models.LfsLock{ID: lock.ID, Path: lock.Path, Owner: lock.Owner.Name, LockedAt: lock.LockedAt}
+	}), nil
+}
+
+func (self *LfsCommands) Lock(task gocui.Task, path string) error {
+	cmdArgs := NewGitCmd("lfs").
+		Arg("lock", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+func (self *LfsCommands) Unlock(task gocui.Task, path string) error {
+	cmdArgs := NewGitCmd("lfs").
+		Arg("unlock", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// UnlockByID releases the lock with the given ID; with force, this also works
+// for locks that somebody else owns
+func (self *LfsCommands) UnlockByID(task gocui.Task, id string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").
+		Arg("unlock", "--id="+id).
+		ArgIf(force, "--force").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Fetch downloads the LFS objects of the given commits from the given remote
+func (self *LfsCommands) Fetch(task gocui.Task, remoteName string, hashes []string) error {
+	cmdArgs := NewGitCmd("lfs").
+		Arg("fetch", remoteName).
+		Arg(hashes...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
@@ -0,0 +1,227 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+const testLfsPointer = `version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`
+
+func TestParseLfsPointer(t *testing.T) {
+	scenarios := []struct {
+		testName   string
+		content    string
+		expected   *LfsPointer
+		expectedOk bool
+	}{
+		{
+			testName: "valid pointer",
+			content:  testLfsPointer,
+			expected: &LfsPointer{
+				Oid:  "sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393",
+				Size: 12345,
+			},
+			expectedOk: true,
+		},
+		{
+			testName:   "regular text file",
+			content:    "hello world\n",
+			expected:   nil,
+			expectedOk: false,
+		},
+		{
+			testName:   "missing oid",
+			content:    "version https://git-lfs.github.com/spec/v1\nsize 12\nfoo bar\n",
+			expected:   nil,
+			expectedOk: false,
+		},
+		{
+			testName:   "invalid size",
+			content:    "version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize big\n",
+			expected:   nil,
+			expectedOk: false,
+		},
+		{
+			testName:   "too big to be a pointer",
+			content:    testLfsPointer + strings.Repeat("x", lfsMaxPointerSize),
+			expected:   nil,
+			expectedOk: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			pointer, ok := ParseLfsPointer(s.content)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expected, pointer)
+		})
+	}
+}
+
+func TestParseLfsLocks(t *testing.T) {
+	output := `[{"id":"3","path":"assets/logo.psd","owner":{"name":"Jane Doe"},"locked_at":"2024-01-02T03:04:05Z"}]`
+
+	locks, err := ParseLfsLocks(output)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.LfsLock{
+		{ID: "3", Path: "assets/logo.psd", Owner: "Jane Doe", LockedAt: "2024-01-02T03:04:05Z"},
+	}, locks)
+}
+
+func TestLfsTrackedPaths(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		gitattributes string
+		paths         []string
+		runner        *oscommands.FakeCmdObjRunner
+		expectedPaths []string
+	}{
+		{
+			testName:      "repo without LFS",
+			gitattributes: "*.txt text\n",
+			paths:         []string{"a.bin", "b.txt"},
+			runner:        oscommands.NewFakeRunner(t),
+			expectedPaths: nil,
+		},
+		{
+			testName:      "repo with LFS",
+			gitattributes: "*.bin filter=lfs diff=lfs merge=lfs -text\n",
+			paths:         []string{"a.bin", "b.txt"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"},
+					"a.bin\x00filter\x00lfs\x00b.txt\x00filter\x00unspecified\x00", nil),
+			expectedPaths: []string{"a.bin"},
+		},
+		{
+			testName:      "no paths",
+			gitattributes: "*.bin filter=lfs diff=lfs merge=lfs -text\n",
+			paths:         nil,
+			runner:        oscommands.NewFakeRunner(t),
+			expectedPaths: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			dir := t.TempDir()
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, ".gitattributes"), []byte(s.gitattributes), 0o644))
+
+			instance := buildLfsCommands(commonDeps{runner: s.runner, repoPaths: MockRepoPaths(dir)})
+
+			result := instance.TrackedPaths(s.paths)
+			assert.ElementsMatch(t, s.expectedPaths, result.ToSlice())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestLfsGetPointer(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "pointer.bin"), []byte(testLfsPointer), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "content.bin"), []byte("hello"), 0o644))
+
+	scenarios := []struct {
+		testName string
+		version  string
+		path     string
+		runner   *oscommands.FakeCmdObjRunner
+		expected *LfsPointer
+	}{
+		{
+			testName: "pointer in working tree",
+			version:  LfsWorkingTreeVersion,
+			path:     "pointer.bin",
+			runner:   oscommands.NewFakeRunner(t),
+			expected: &LfsPointer{
+				Oid:  "sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393",
+				Size: 12345,
+			},
+		},
+		{
+			testName: "actual content in working tree",
+			version:  LfsWorkingTreeVersion,
+			path:     "content.bin",
+			runner:   oscommands.NewFakeRunner(t),
+			expected: &LfsPointer{
+				Oid:         "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				Size:        5,
+				NotAPointer: true,
+			},
+		},
+		{
+			testName: "missing from working tree",
+			version:  LfsWorkingTreeVersion,
+			path:     "missing.bin",
+			runner:   oscommands.NewFakeRunner(t),
+			expected: nil,
+		},
+		{
+			testName: "pointer in index",
+			version:  LfsIndexVersion,
+			path:     "pointer.bin",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":pointer.bin"}, "130\n", nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":pointer.bin"}, testLfsPointer, nil),
+			expected: &LfsPointer{
+				Oid:  "sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393",
+				Size: 12345,
+			},
+		},
+		{
+			testName: "content in commit that is too big to be a pointer",
+			version:  "HEAD",
+			path:     "content.bin",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:content.bin"}, "5000000\n", nil),
+			expected: &LfsPointer{
+				Size:        5000000,
+				NotAPointer: true,
+			},
+		},
+		{
+			testName: "missing from commit",
+			version:  "HEAD",
+			path:     "pointer.bin",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:pointer.bin"}, "", assert.AnError),
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildLfsCommands(commonDeps{runner: s.runner, repoPaths: MockRepoPaths(dir)})
+
+			pointer, err := instance.GetPointer(s.version, s.path)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, pointer)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestLfsLockAndFetch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"lfs", "lock", "--", "a.bin"}, "", nil).
+		ExpectGitArgs([]string{"lfs", "unlock", "--", "a.bin"}, "", nil).
+		ExpectGitArgs([]string{"lfs", "unlock", "--id=3", "--force"}, "", nil).
+		ExpectGitArgs([]string{"lfs", "fetch", "origin", "abc", "def"}, "", nil)
+	instance := buildLfsCommands(commonDeps{runner: runner})
+	task := gocui.NewFakeTask()
+
+	assert.NoError(t, instance.Lock(task, "a.bin"))
+	assert.NoError(t, instance.Unlock(task, "a.bin"))
+	assert.NoError(t, instance.UnlockByID(task, "3", true))
+	assert.NoError(t, instance.Fetch(task, "origin", []string{"abc", "def"}))
+	runner.CheckForMissingCalls()
+}
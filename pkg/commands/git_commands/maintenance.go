@@ -0,0 +1,165 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type MaintenanceCommands struct {
+	*GitCommon
+}
+
+func NewMaintenanceCommands(gitCommon *GitCommon) *MaintenanceCommands {
+	return &MaintenanceCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// The tasks that can be passed to git maintenance run --task
+var MaintenanceTasks = []string{"commit-graph", "prefetch", "loose-objects", "incremental-repack", "pack-refs"}
+
+// CountObjects returns statistics about the object database
+func (self *MaintenanceCommands) CountObjects() (*models.ObjectCounts, error) {
+	cmdArgs := NewGitCmd("count-objects").
+		Arg("-v").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseObjectCounts(output), nil
+}
+
+func parseObjectCounts(output string) *models.ObjectCounts {
+	counts := &models.ObjectCounts{}
+	for _, line := range utils.SplitLines(output) {
+		key, valueStr, found := strings.Cut(line, ": ")
+		if !found {
+			continue
+		}
+		value, err := strconv.ParseInt(valueStr, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// count-objects reports sizes in KiB
+		switch key {
+		case "count":
+			counts.LooseObjects = int(value)
+		case "size":
+			counts.LooseSize = value * 1024
+		case "in-pack":
+			counts.PackedObjects = int(value)
+		case "packs":
+			counts.Packs = int(value)
+		case "size-pack":
+			counts.PackSize = value * 1024
+		case "prune-packable":
+			counts.PrunePackable = int(value)
+		case "garbage":
+			counts.Garbage = int(value)
+		case "size-garbage":
+			counts.GarbageSize = value * 1024
+		}
+	}
+
+	return counts
+}
+
+// HasCommitGraph returns true if git has written a commit-graph file, either
+// as a single file or as a chain of incremental ones
+func (self *MaintenanceCommands) HasCommitGraph() bool {
+	infoDir := filepath.Join(self.repoPaths.RepoGitDirPath(), "objects", "info")
+	for _, path := range []string{
+		filepath.Join(infoDir, "commit-graph"),
+		filepath.Join(infoDir, "commit-graphs", "commit-graph-chain"),
+	} {
+		if _, err := self.Fs.Stat(path); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRegistered returns true if the repo is registered for git's background
+// maintenance, which lists the registered repos in the global config
+func (self *MaintenanceCommands) IsRegistered() bool {
+	cmdArgs := NewGitCmd("config").
+		Arg("--get-all", "maintenance.repo").
+		ToArgv()
+
+	// git config exits with 1 if the key isn't set
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+
+	repoPath := filepath.Clean(self.repoPaths.RepoPath())
+	for _, path := range utils.SplitLines(output) {
+		if filepath.Clean(path) == repoPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (self *MaintenanceCommands) Register() error {
+	cmdArgs := NewGitCmd("maintenance").
+		Arg("register").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *MaintenanceCommands) Unregister() error {
+	cmdArgs := NewGitCmd("maintenance").
+		Arg("unregister").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Gc cleans up unnecessary files and optimizes the repository
+func (self *MaintenanceCommands) Gc() error {
+	cmdArgs := NewGitCmd("gc").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
+// RunTask runs a single task of git maintenance, e.g. "commit-graph"
+func (self *MaintenanceCommands) RunTask(task string) error {
+	cmdArgs := NewGitCmd("maintenance").
+		Arg("run", "--task="+task).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
+// PruneWorktrees removes the administrative files of worktrees whose
+// directories were deleted
+func (self *MaintenanceCommands) PruneWorktrees() error {
+	cmdArgs := NewGitCmd("worktree").
+		Arg("prune", "--verbose").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
+// PruneRemote deletes the remote-tracking branches of the given remote whose
+// branches were deleted on the remote
+func (self *MaintenanceCommands) PruneRemote(remoteName string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune", remoteName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
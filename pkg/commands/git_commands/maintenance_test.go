@@ -0,0 +1,122 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceCountObjects(t *testing.T) {
+	output := "count: 7\nsize: 28\nin-pack: 1200\npacks: 2\nsize-pack: 512\nprune-packable: 3\ngarbage: 1\nsize-garbage: 4\n"
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"count-objects", "-v"}, output, nil)
+	instance := buildMaintenanceCommands(commonDeps{runner: runner})
+
+	counts, err := instance.CountObjects()
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ObjectCounts{
+		LooseObjects:  7,
+		LooseSize:     28 * 1024,
+		PackedObjects: 1200,
+		Packs:         2,
+		PackSize:      512 * 1024,
+		PrunePackable: 3,
+		Garbage:       1,
+		GarbageSize:   4 * 1024,
+	}, counts)
+	runner.CheckForMissingCalls()
+}
+
+func TestMaintenanceHasCommitGraph(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		path     string
+		expected bool
+	}{
+		{
+			testName: "no commit-graph",
+			path:     "",
+			expected: false,
+		},
+		{
+			testName: "single commit-graph file",
+			path:     "/repo/.git/objects/info/commit-graph",
+			expected: true,
+		},
+		{
+			testName: "chain of commit-graph files",
+			path:     "/repo/.git/objects/info/commit-graphs/commit-graph-chain",
+			expected: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.path != "" {
+				assert.NoError(t, afero.WriteFile(fs, s.path, []byte{}, 0o644))
+			}
+			instance := buildMaintenanceCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+			assert.Equal(t, s.expected, instance.HasCommitGraph())
+		})
+	}
+}
+
+func TestMaintenanceIsRegistered(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		output   string
+		err      error
+		expected bool
+	}{
+		{
+			testName: "no repos registered",
+			output:   "",
+			err:      errors.New("exit status 1"),
+			expected: false,
+		},
+		{
+			testName: "other repos registered",
+			output:   "/other\n/repo2\n",
+			err:      nil,
+			expected: false,
+		},
+		{
+			testName: "repo registered",
+			output:   "/other\n/repo/\n",
+			err:      nil,
+			expected: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get-all", "maintenance.repo"}, s.output, s.err)
+			instance := buildMaintenanceCommands(commonDeps{runner: runner, repoPaths: MockRepoPaths("/repo")})
+
+			assert.Equal(t, s.expected, instance.IsRegistered())
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestMaintenanceCommands(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"gc"}, "", nil).
+		ExpectGitArgs([]string{"maintenance", "run", "--task=commit-graph"}, "", nil).
+		ExpectGitArgs([]string{"worktree", "prune", "--verbose"}, "", nil).
+		ExpectGitArgs([]string{"remote", "prune", "origin"}, "", nil)
+	instance := buildMaintenanceCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Gc())
+	assert.NoError(t, instance.RunTask("commit-graph"))
+	assert.NoError(t, instance.PruneWorktrees())
+	assert.NoError(t, instance.PruneRemote("origin"))
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,122 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+)
+
+const defaultNotesRef = "refs/notes/commits"
+
+type NotesCommands struct {
+	*GitCommon
+}
+
+func NewNotesCommands(gitCommon *GitCommon) *NotesCommands {
+	return &NotesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// NotesRefs returns the notes refs whose notes we show: the default one
+// (core.notesRef, or refs/notes/commits if unset), followed by the extra ones
+// configured in git.notesRefs
+func (self *NotesCommands) NotesRefs() []string {
+	return append([]string{self.DefaultRef()}, extraNotesRefs(self.GitCommon)...)
+}
+
+// DefaultRef returns the notes ref that git uses when no ref is specified
+func (self *NotesCommands) DefaultRef() string {
+	return getDefaultNotesRef(self.config)
+}
+
+// Get returns the note that annotates the given commit in the given notes ref,
+// or an empty string if there is none
+func (self *NotesCommands) Get(notesRef string, hash string) string {
+	cmdArgs := NewGitCmd("notes").
+		Arg("--ref="+notesRef).
+		Arg("show", hash).
+		ToArgv()
+
+	// git notes show fails if there is no note
+	note, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(note, "\n")
+}
+
+// Set adds a note to the given commit, replacing any note it already has
+func (self *NotesCommands) Set(notesRef string, hash string, note string) error {
+	cmdArgs := NewGitCmd("notes").
+		Arg("--ref="+notesRef).
+		Arg("add", "--force", "-m", note, hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// SetWithEditorCmdObj lets the user edit a note for the given commit in their
+// editor, starting out with the contents of the given file
+func (self *NotesCommands) SetWithEditorCmdObj(notesRef string, hash string, filepath string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("notes").
+		Arg("--ref="+notesRef).
+		Arg("add", "--force", "--file="+filepath, "--edit", hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
+func (self *NotesCommands) Remove(notesRef string, hash string) error {
+	cmdArgs := NewGitCmd("notes").
+		Arg("--ref="+notesRef).
+		Arg("remove", hash).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *NotesCommands) Push(task gocui.Task, remoteName string, notesRef string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg(remoteName, notesRef+":"+notesRef).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+func (self *NotesCommands) Fetch(task gocui.Task, remoteName string, notesRef string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg(remoteName, notesRef+":"+notesRef).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// The notes refs configured in git.notesRefs, except the default one
+func extraNotesRefs(gitCommon *GitCommon) []string {
+	refs := lo.Map(gitCommon.UserConfig().Git.NotesRefs, func(notesRef string, _ int) string {
+		return normalizeNotesRef(notesRef)
+	})
+	return lo.Without(lo.Uniq(refs), getDefaultNotesRef(gitCommon.config))
+}
+
+func getDefaultNotesRef(config *ConfigCommands) string {
+	if notesRef := config.GetNotesRef(); notesRef != "" {
+		return normalizeNotesRef(notesRef)
+	}
+
+	return defaultNotesRef
+}
+
+// Notes refs can be configured without the refs/notes/ prefix, like git does
+// for the --ref option of git notes
+func normalizeNotesRef(notesRef string) string {
+	if strings.HasPrefix(notesRef, "refs/") {
+		return notesRef
+	}
+
+	return "refs/notes/" + notesRef
+}
@@ -0,0 +1,100 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotesRefs(t *testing.T) {
+	scenarios := []struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		notesRefs              []string
+		expected               []string
+	}{
+		{
+			testName:               "default ref only",
+			gitConfigMockResponses: nil,
+			notesRefs:              nil,
+			expected:               []string{"refs/notes/commits"},
+		},
+		{
+			testName:               "default ref from git config",
+			gitConfigMockResponses: map[string]string{"core.notesRef": "mine"},
+			notesRefs:              nil,
+			expected:               []string{"refs/notes/mine"},
+		},
+		{
+			testName:               "extra refs without duplicates",
+			gitConfigMockResponses: nil,
+			notesRefs:              []string{"review", "refs/notes/review", "refs/notes/commits", "refs/other/notes"},
+			expected:               []string{"refs/notes/commits", "refs/notes/review", "refs/other/notes"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.NotesRefs = s.notesRefs
+			instance := buildNotesCommands(commonDeps{
+				userConfig: userConfig,
+				gitConfig:  git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+			})
+
+			assert.Equal(t, s.expected, instance.NotesRefs())
+		})
+	}
+}
+
+func TestNotesGet(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected string
+	}{
+		{
+			testName: "commit has a note",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "show", "1234567890"}, "line 1\nline 2\n", nil),
+			expected: "line 1\nline 2",
+		},
+		{
+			testName: "commit has no note",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "show", "1234567890"}, "", errors.New("error: no note found for object 1234567890.")),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildNotesCommands(commonDeps{runner: s.runner})
+
+			assert.Equal(t, s.expected, instance.Get("refs/notes/commits", "1234567890"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestNotesSet(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"notes", "--ref=refs/notes/review", "add", "--force", "-m", "looks good", "1234567890"}, "", nil)
+	instance := buildNotesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Set("refs/notes/review", "1234567890", "looks good"))
+	runner.CheckForMissingCalls()
+}
+
+func TestNotesRemove(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "remove", "1234567890"}, "", nil)
+	instance := buildNotesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Remove("refs/notes/commits", "1234567890"))
+	runner.CheckForMissingCalls()
+}
@@ -45,6 +45,11 @@ type ApplyPatchOpts struct {
 	Cached   bool
 	Index    bool
 	Reverse  bool
+	// Needed for patches without context lines
+	UnidiffZero bool
+	// If set, Cached applies the patch to this index file instead of the
+	// repository's index
+	IndexFile string
 }
 
 func (self *PatchCommands) ApplyCustomPatch(reverse bool, turnAddedFilesIntoDiffAgainstEmptyFile bool) error {
@@ -72,10 +77,15 @@ func (self *PatchCommands) applyPatchFile(filepath string, opts ApplyPatchOpts)
 		ArgIf(opts.Cached, "--cached").
 		ArgIf(opts.Index, "--index").
 		ArgIf(opts.Reverse, "--reverse").
+		ArgIf(opts.UnidiffZero, "--unidiff-zero").
 		Arg(filepath).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	cmdObj := self.cmd.New(cmdArgs)
+	if opts.IndexFile != "" {
+		cmdObj.AddEnvVars("GIT_INDEX_FILE=" + opts.IndexFile)
+	}
+	return cmdObj.Run()
 }
 
 func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
@@ -0,0 +1,54 @@
+package git_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type PatchSeriesCommands struct {
+	*GitCommon
+}
+
+func NewPatchSeriesCommands(gitCommon *GitCommon) *PatchSeriesCommands {
+	return &PatchSeriesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+type FormatPatchOpts struct {
+	// The oldest and the newest commit of the series
+	From *models.Commit
+	To   *models.Commit
+	// Directory to write the patch files to, relative to the working tree root
+	OutputDir   string
+	CoverLetter bool
+}
+
+// FormatPatch exports the given commits as a numbered series of patch files,
+// suitable for sending by email, and returns the paths of the files
+func (self *PatchSeriesCommands) FormatPatch(opts FormatPatchOpts) ([]string, error) {
+	cmdArgs := NewGitCmd("format-patch").
+		Arg("--output-directory", opts.OutputDir).
+		ArgIf(opts.CoverLetter, "--cover-letter").
+		ArgIfElse(opts.From.IsFirstCommit(), "--root", opts.From.Hash()+"^.."+opts.To.Hash()).
+		ArgIf(opts.From.IsFirstCommit(), opts.To.Hash()).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// ApplyMailbox applies the patches in the given mbox or patch file as commits,
+// falling back to a three-way merge if a patch doesn't apply cleanly. If that
+// results in conflicts, git am stops and waits for them to be resolved.
+func (self *PatchSeriesCommands) ApplyMailbox(path string) error {
+	cmdArgs := NewGitCmd("am").
+		Arg("-3", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,69 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchSeriesFormatPatch(t *testing.T) {
+	hashPool := &utils.StringPool{}
+	first := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "aaa"})
+	second := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "bbb", Parents: []string{"aaa"}})
+	third := models.NewCommit(hashPool, models.NewCommitOpts{Hash: "ccc", Parents: []string{"bbb"}})
+
+	scenarios := []struct {
+		testName      string
+		opts          FormatPatchOpts
+		runner        *oscommands.FakeCmdObjRunner
+		expectedPaths []string
+	}{
+		{
+			testName: "range of commits",
+			opts:     FormatPatchOpts{From: second, To: third, OutputDir: "patches"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "--output-directory", "patches", "bbb^..ccc"},
+					"patches/0001-second.patch\npatches/0002-third.patch\n", nil),
+			expectedPaths: []string{"patches/0001-second.patch", "patches/0002-third.patch"},
+		},
+		{
+			testName: "with cover letter",
+			opts:     FormatPatchOpts{From: third, To: third, OutputDir: "patches", CoverLetter: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "--output-directory", "patches", "--cover-letter", "ccc^..ccc"},
+					"patches/0000-cover-letter.patch\npatches/0001-third.patch\n", nil),
+			expectedPaths: []string{"patches/0000-cover-letter.patch", "patches/0001-third.patch"},
+		},
+		{
+			testName: "starting at the root commit",
+			opts:     FormatPatchOpts{From: first, To: second, OutputDir: "patches"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"format-patch", "--output-directory", "patches", "--root", "bbb"},
+					"patches/0001-first.patch\npatches/0002-second.patch\n", nil),
+			expectedPaths: []string{"patches/0001-first.patch", "patches/0002-second.patch"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildPatchSeriesCommands(commonDeps{runner: s.runner})
+
+			paths, err := instance.FormatPatch(s.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPaths, paths)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestPatchSeriesApplyMailbox(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"am", "-3", "series.mbox"}, "", nil)
+	instance := buildPatchSeriesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyMailbox("series.mbox"))
+	runner.CheckForMissingCalls()
+}
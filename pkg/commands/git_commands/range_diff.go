@@ -0,0 +1,148 @@
+package git_commands
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+type RangeDiffCommands struct {
+	*GitCommon
+}
+
+func NewRangeDiffCommands(gitCommon *GitCommon) *RangeDiffCommands {
+	return &RangeDiffCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// GetPairs runs git range-diff for the two given ranges, e.g. "main..old" and
+// "main..new", and returns the pairs of corresponding commits
+func (self *RangeDiffCommands) GetPairs(oldRange string, newRange string) ([]*models.RangeDiffPair, error) {
+	cmdArgs := NewGitCmd("range-diff").
+		Arg("--no-color").
+		Arg(oldRange, newRange).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRangeDiff(output), nil
+}
+
+// RangesSince returns the ranges for comparing the commits that two versions
+// of a branch have on top of the main branch, e.g. the branch before and after
+// a rebase. Each side starts at its own fork point from the closest main
+// branch, so that commits that the main branch gained in the meantime don't
+// show up as added. If a ref has no fork point (e.g. because it is on a main
+// branch itself), both sides start at the merge base of the two refs instead.
+func (self *RangeDiffCommands) RangesSince(oldRef string, newRef string, mainBranches *MainBranches) (string, string, error) {
+	oldBase, newBase, err := self.forkPoints(oldRef, newRef, mainBranches)
+	if err != nil {
+		return "", "", err
+	}
+
+	if oldBase == "" || newBase == "" {
+		cmdArgs := NewGitCmd("merge-base").
+			Arg(oldRef, newRef).
+			ToArgv()
+
+		output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+		if err != nil {
+			return "", "", err
+		}
+
+		oldBase = strings.TrimSpace(output)
+		newBase = oldBase
+	}
+
+	return oldBase + ".." + oldRef, newBase + ".." + newRef, nil
+}
+
+// Returns the merge bases of the two refs with the closest main branch, or
+// empty strings if either of them has none or is contained in a main branch
+func (self *RangeDiffCommands) forkPoints(oldRef string, newRef string, mainBranches *MainBranches) (string, string, error) {
+	oldBase := mainBranches.GetMergeBase(oldRef)
+	newBase := mainBranches.GetMergeBase(newRef)
+	if oldBase == "" || newBase == "" {
+		return "", "", nil
+	}
+
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg(oldRef, newRef).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", "", err
+	}
+
+	hashes := strings.Fields(output)
+	if len(hashes) != 2 || hashes[0] == oldBase || hashes[1] == newBase {
+		return "", "", nil
+	}
+
+	return oldBase, newBase, nil
+}
+
+// Matches the line that git range-diff prints for each pair, e.g.
+// "1:  0123abc ! 1:  4567def Commit subject"; the side that a commit doesn't
+// exist on is printed as "-:  -------"
+var rangeDiffPairRegexp = regexp.MustCompile(`^\s*(-|\d+):\s+(-+|[0-9a-f]+) ([=!<>])\s+(-|\d+):\s+(-+|[0-9a-f]+) (.*)$`)
+
+func parseRangeDiff(output string) []*models.RangeDiffPair {
+	pairs := []*models.RangeDiffPair{}
+	var interdiffLines []string
+
+	flushInterdiff := func() {
+		if len(pairs) > 0 && len(interdiffLines) > 0 {
+			pairs[len(pairs)-1].Interdiff = strings.TrimRight(strings.Join(interdiffLines, "\n"), "\n")
+		}
+		interdiffLines = nil
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		match := rangeDiffPairRegexp.FindStringSubmatch(line)
+		if match == nil {
+			// The interdiff of a modified pair follows its line, indented
+			// by four spaces
+			if len(pairs) > 0 {
+				interdiffLines = append(interdiffLines, strings.TrimPrefix(line, "    "))
+			}
+			continue
+		}
+
+		flushInterdiff()
+
+		pair := &models.RangeDiffPair{Subject: match[6]}
+		pair.OldIndex, pair.OldHash = parseRangeDiffSide(match[1], match[2])
+		pair.NewIndex, pair.NewHash = parseRangeDiffSide(match[4], match[5])
+		switch match[3] {
+		case "=":
+			pair.Status = models.RangeDiffIdentical
+		case "!":
+			pair.Status = models.RangeDiffModified
+		case "<":
+			pair.Status = models.RangeDiffRemoved
+		case ">":
+			pair.Status = models.RangeDiffAdded
+		}
+		pairs = append(pairs, pair)
+	}
+	flushInterdiff()
+
+	return pairs
+}
+
+func parseRangeDiffSide(index string, hash string) (int, string) {
+	if index == "-" {
+		return 0, ""
+	}
+
+	i, _ := strconv.Atoi(index)
+	return i, hash
+}
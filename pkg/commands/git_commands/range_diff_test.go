@@ -0,0 +1,137 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeDiff(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		output   string
+		expected []*models.RangeDiffPair
+	}{
+		{
+			testName: "empty output",
+			output:   "",
+			expected: []*models.RangeDiffPair{},
+		},
+		{
+			testName: "all kinds of pairs",
+			output: `1:  46ee4a9 = 1:  46ee4a9 add b
+2:  e14a24a ! 2:  b889c8b change b
+    @@ b
+      9
+     -10
+    -+changed
+    ++changed2
+
+3:  32ac025 < -:  ------- remove c
+-:  ------- > 3:  2158921 add d
+`,
+			expected: []*models.RangeDiffPair{
+				{Status: models.RangeDiffIdentical, OldIndex: 1, OldHash: "46ee4a9", NewIndex: 1, NewHash: "46ee4a9", Subject: "add b"},
+				{
+					Status: models.RangeDiffModified, OldIndex: 2, OldHash: "e14a24a", NewIndex: 2, NewHash: "b889c8b", Subject: "change b",
+					Interdiff: "@@ b\n  9\n -10\n-+changed\n++changed2",
+				},
+				{Status: models.RangeDiffRemoved, OldIndex: 3, OldHash: "32ac025", Subject: "remove c"},
+				{Status: models.RangeDiffAdded, NewIndex: 3, NewHash: "2158921", Subject: "add d"},
+			},
+		},
+		{
+			testName: "more than ten commits",
+			output:   " 9:  0123abc =  9:  0123abc nine\n10:  4567def ! 10:  89abcde ten\n",
+			expected: []*models.RangeDiffPair{
+				{Status: models.RangeDiffIdentical, OldIndex: 9, OldHash: "0123abc", NewIndex: 9, NewHash: "0123abc", Subject: "nine"},
+				{Status: models.RangeDiffModified, OldIndex: 10, OldHash: "4567def", NewIndex: 10, NewHash: "89abcde", Subject: "ten"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, parseRangeDiff(s.output))
+		})
+	}
+}
+
+func TestRangeDiffGetPairs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"range-diff", "--no-color", "main..old", "main..new"}, "1:  46ee4a9 = 1:  46ee4a9 add b\n", nil)
+	instance := buildRangeDiffCommands(commonDeps{runner: runner})
+
+	pairs, err := instance.GetPairs("main..old", "main..new")
+	assert.NoError(t, err)
+	assert.Len(t, pairs, 1)
+	runner.CheckForMissingCalls()
+}
+
+func TestRangeDiffRangesSince(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		runner           *oscommands.FakeCmdObjRunner
+		oldRef           string
+		newRef           string
+		expectedOldRange string
+		expectedNewRange string
+	}{
+		{
+			testName: "each side starts at its own fork point",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master\n", nil).
+				ExpectGitArgs([]string{"merge-base", "origin/feature", "refs/remotes/origin/master"}, "m1\n", nil).
+				ExpectGitArgs([]string{"merge-base", "feature", "refs/remotes/origin/master"}, "m2\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "origin/feature", "feature"}, "f1\nf2\n", nil),
+			oldRef:           "origin/feature",
+			newRef:           "feature",
+			expectedOldRange: "m1..origin/feature",
+			expectedNewRange: "m2..feature",
+		},
+		{
+			testName: "refs on a main branch use the merge base of both refs",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master\n", nil).
+				ExpectGitArgs([]string{"merge-base", "HEAD@{1}", "refs/remotes/origin/master"}, "m1\n", nil).
+				ExpectGitArgs([]string{"merge-base", "HEAD", "refs/remotes/origin/master"}, "m2\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "HEAD@{1}", "HEAD"}, "m1\nm3\n", nil).
+				ExpectGitArgs([]string{"merge-base", "HEAD@{1}", "HEAD"}, "abc123\n", nil),
+			oldRef:           "HEAD@{1}",
+			newRef:           "HEAD",
+			expectedOldRange: "abc123..HEAD@{1}",
+			expectedNewRange: "abc123..HEAD",
+		},
+		{
+			testName: "no main branch",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/master"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/heads/master"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"merge-base", "HEAD@{1}", "HEAD"}, "abc123\n", nil),
+			oldRef:           "HEAD@{1}",
+			newRef:           "HEAD",
+			expectedOldRange: "abc123..HEAD@{1}",
+			expectedNewRange: "abc123..HEAD",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.MainBranches = []string{"master"}
+			instance := buildRangeDiffCommands(commonDeps{runner: s.runner, userConfig: userConfig})
+			mainBranches := NewMainBranches(instance.Common, instance.cmd)
+
+			oldRange, newRange, err := instance.RangesSince(s.oldRef, s.newRef, mainBranches)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedOldRange, oldRange)
+			assert.Equal(t, s.expectedNewRange, newRange)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -2,6 +2,7 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -55,6 +56,24 @@ func (self *RebaseCommands) RewordCommit(commits []*models.Commit, index int, su
 	return self.ContinueRebase()
 }
 
+// RewordCommits gives the commits between start and end (inclusive) the
+// messages in newMessages, which is keyed by commit hash. Commits without an
+// entry keep their message.
+func (self *RebaseCommands) RewordCommits(commits []*models.Commit, start, end int, newMessages map[string]CommitMessage) error {
+	if self.config.NeedsGpgSubprocessForCommit() {
+		return errors.New(self.Tr.DisabledForGPG)
+	}
+
+	return self.GenericAmend(commits, start, end, func(commit *models.Commit) error {
+		message, ok := newMessages[commit.Hash()]
+		if !ok {
+			return nil
+		}
+
+		return self.commit.RewordLastCommit(message.Summary, message.Description).Run()
+	})
+}
+
 func (self *RebaseCommands) RewordCommitInEditor(commits []*models.Commit, index int) (*oscommands.CmdObj, error) {
 	changes := []daemon.ChangeTodoAction{{
 		Hash:      commits[index].Hash(),
@@ -86,6 +105,18 @@ func (self *RebaseCommands) AddCommitCoAuthor(commits []*models.Commit, start, e
 	})
 }
 
+// EditCommitTrailers changes the trailers of the commits between start and end
+// (inclusive) using the edit function, which is called once per commit.
+func (self *RebaseCommands) EditCommitTrailers(commits []*models.Commit, start, end int, edit func([]Trailer) []Trailer) error {
+	if self.config.NeedsGpgSubprocessForCommit() {
+		return errors.New(self.Tr.DisabledForGPG)
+	}
+
+	return self.GenericAmend(commits, start, end, func(commit *models.Commit) error {
+		return self.commit.EditTrailers(commit.Hash(), edit)
+	})
+}
+
 func (self *RebaseCommands) GenericAmend(commits []*models.Commit, start, end int, f func(commit *models.Commit) error) error {
 	if start == end && models.IsHeadCommit(commits, start) {
 		// we've selected the top commit so no rebase is required
@@ -207,6 +238,7 @@ type PrepareInteractiveRebaseCommandOpts struct {
 	instruction                daemon.Instruction
 	overrideEditor             bool
 	keepCommitsThatBecomeEmpty bool
+	updateRefs                 bool
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
@@ -222,6 +254,7 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 		ArgIf(opts.keepCommitsThatBecomeEmpty, "--empty=keep").
 		Arg("--no-autosquash").
 		Arg("--rebase-merges").
+		ArgIf(opts.updateRefs, "--update-refs").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
 		Arg(opts.baseHashOrRoot).
 		ToArgv()
@@ -260,6 +293,13 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 
 // GitRebaseEditTodo runs "git rebase --edit-todo", saving the given todosFileContent to the file
 func (self *RebaseCommands) GitRebaseEditTodo(todosFileContent []byte) error {
+	return self.gitRebaseEditTodoWithInstruction(daemon.NewWriteRebaseTodoInstruction(todosFileContent))
+}
+
+// Runs "git rebase --edit-todo" with lazygit as the sequence editor, which
+// applies the given instruction to the todo file. This lets git validate the
+// changed todos, unlike editing the file directly.
+func (self *RebaseCommands) gitRebaseEditTodoWithInstruction(instruction daemon.Instruction) error {
 	ex := oscommands.GetLazygitPath()
 
 	cmdArgs := NewGitCmd("rebase").
@@ -275,7 +315,7 @@ func (self *RebaseCommands) GitRebaseEditTodo(todosFileContent []byte) error {
 
 	cmdObj := self.cmd.New(cmdArgs)
 
-	cmdObj.AddEnvVars(daemon.ToEnvVars(daemon.NewWriteRebaseTodoInstruction(todosFileContent))...)
+	cmdObj.AddEnvVars(daemon.ToEnvVars(instruction)...)
 
 	cmdObj.AddEnvVars(
 		"DEBUG="+debug,
@@ -368,6 +408,72 @@ func (self *RebaseCommands) DeleteUpdateRefTodos(commits []*models.Commit) error
 	return self.GitRebaseEditTodo(todosFileContent)
 }
 
+// Inserts a todo without a commit (exec, label, reset, or merge) so that it
+// is executed right after the commit at the given index. This must be a todo,
+// or the first commit after the todos, in which case the new todo is executed
+// first.
+func (self *RebaseCommands) InsertTodoAfter(commits []*models.Commit, index int, newTodo todo.Todo) error {
+	return self.gitRebaseEditTodoWithInstruction(
+		daemon.NewInsertTodoInstruction(todoIndex(commits, index), newTodo))
+}
+
+// Replaces the todo at the given index, e.g. to change the command of an exec
+// todo or the label of a label, reset, or merge todo
+func (self *RebaseCommands) ReplaceTodo(commits []*models.Commit, index int, newTodo todo.Todo) error {
+	return self.gitRebaseEditTodoWithInstruction(
+		daemon.NewReplaceTodoInstruction(todoIndex(commits, index), commits[index].Action, newTodo))
+}
+
+func (self *RebaseCommands) RemoveTodo(commits []*models.Commit, index int) error {
+	return self.gitRebaseEditTodoWithInstruction(
+		daemon.NewRemoveTodoInstruction(todoIndex(commits, index), commits[index].Action))
+}
+
+// Returns the full todo for the commit at the given index; our commit model
+// doesn't have all of its fields (e.g. the label of a merge todo)
+func (self *RebaseCommands) GetTodo(commits []*models.Commit, index int) (todo.Todo, error) {
+	return utils.GetTodo(
+		filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo"),
+		todoIndex(commits, index),
+		self.config.GetCoreCommentChar(),
+	)
+}
+
+// Returns the labels that are defined by the label todos of the current
+// rebase, both the executed ones and the pending ones; these are the ones
+// that reset and merge todos can refer to (besides refs and hashes)
+func (self *RebaseCommands) GetTodoLabels() ([]string, error) {
+	labels := []string{}
+	for _, fileName := range []string{"rebase-merge/done", "rebase-merge/git-rebase-todo"} {
+		todos, err := utils.ReadRebaseTodoFile(
+			filepath.Join(self.repoPaths.WorktreeGitDirPath(), fileName),
+			self.config.GetCoreCommentChar(),
+		)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, t := range todos {
+			if t.Command == todo.Label {
+				labels = append(labels, t.Label)
+			}
+		}
+	}
+
+	return lo.Uniq(labels), nil
+}
+
+// Converts the index of a commit in the commits view to the index of its todo
+// among the rendered todos (see utils.InsertTodo)
+func todoIndex(commits []*models.Commit, index int) int {
+	return lo.CountBy(commits[:index], func(commit *models.Commit) bool {
+		return commit.Status == models.StatusRebasing
+	})
+}
+
 func (self *RebaseCommands) MoveTodosDown(commits []*models.Commit) error {
 	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
 	todosToMove := lo.Map(commits, func(commit *models.Commit, _ int) utils.Todo {
@@ -463,6 +569,18 @@ func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string,
 	}).Run()
 }
 
+// RebaseStack rebases the commits of the checked-out branch after the given
+// fork point onto the given base, moving along all branches that point to
+// these commits (regardless of the rebase.updateRefs config), so that the
+// stack stays together
+func (self *RebaseCommands) RebaseStack(base string, forkPoint string) error {
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: forkPoint,
+		onto:           base,
+		updateRefs:     true,
+	}).Run()
+}
+
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd(commandType).Arg("--" + command).ToArgv()
 
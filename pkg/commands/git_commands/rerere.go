@@ -0,0 +1,184 @@
+package git_commands
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/spf13/afero"
+)
+
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// matches e.g. "preimage" or "preimage.1"
+var rerereVariantRegexp = regexp.MustCompile(`^preimage(\.\d+)?$`)
+
+func (self *RerereCommands) cacheDir() string {
+	return filepath.Join(self.repoPaths.RepoGitDirPath(), "rr-cache")
+}
+
+// IsEnabled returns true if git records and reuses conflict resolutions
+func (self *RerereCommands) IsEnabled() bool {
+	enabled, isSet := self.config.GetRerereEnabled()
+	if isSet {
+		return enabled
+	}
+
+	_, err := self.Fs.Stat(self.cacheDir())
+	return err == nil
+}
+
+// Remaining returns the conflicted paths that rerere didn't resolve
+func (self *RerereCommands) Remaining() ([]string, error) {
+	cmdArgs := NewGitCmd("rerere").
+		Arg("remaining").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Forget discards the recorded resolutions of the conflicts in the given file
+func (self *RerereCommands) Forget(path string) error {
+	cmdArgs := NewGitCmd("rerere").
+		Arg("forget", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// RecreateConflict brings back the conflict markers of a file that was
+// resolved, discarding the resolution
+func (self *RerereCommands) RecreateConflict(path string) error {
+	cmdArgs := NewGitCmd("checkout").
+		Arg("-m", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// GetResolutions returns the recorded conflicts, most recent first
+func (self *RerereCommands) GetResolutions() ([]*models.RerereResolution, error) {
+	entries, err := afero.ReadDir(self.Fs, self.cacheDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resolutions := []*models.RerereResolution{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		dir := filepath.Join(self.cacheDir(), entry.Name())
+		files, err := afero.ReadDir(self.Fs, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			match := rerereVariantRegexp.FindStringSubmatch(file.Name())
+			if match == nil {
+				continue
+			}
+
+			resolution := &models.RerereResolution{
+				Hash:       entry.Name(),
+				Variant:    match[1],
+				Dir:        dir,
+				RecordedAt: file.ModTime(),
+			}
+			if postimage, err := self.Fs.Stat(resolution.PostimagePath()); err == nil {
+				resolution.Resolved = true
+				resolution.RecordedAt = postimage.ModTime()
+			}
+			if preimage, err := afero.ReadFile(self.Fs, resolution.PreimagePath()); err == nil {
+				resolution.Summary = rerereConflictSummary(string(preimage))
+			}
+
+			resolutions = append(resolutions, resolution)
+		}
+	}
+
+	sort.SliceStable(resolutions, func(i, j int) bool {
+		return resolutions[i].RecordedAt.After(resolutions[j].RecordedAt)
+	})
+
+	return resolutions, nil
+}
+
+// rerere writes the conflict markers of preimages without labels, so the first
+// line after "<<<<<<<" is the first line of the conflicting hunk
+func rerereConflictSummary(preimage string) string {
+	lines := strings.Split(preimage, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "<<<<<<<") {
+			for _, hunkLine := range lines[i+1:] {
+				if strings.HasPrefix(hunkLine, "=======") {
+					break
+				}
+				if strings.TrimSpace(hunkLine) != "" {
+					return strings.TrimSpace(hunkLine)
+				}
+			}
+			break
+		}
+	}
+
+	return ""
+}
+
+// GetPreimage returns the conflicted file that rerere recorded
+func (self *RerereCommands) GetPreimage(resolution *models.RerereResolution) (string, error) {
+	content, err := afero.ReadFile(self.Fs, resolution.PreimagePath())
+	return string(content), err
+}
+
+// DeleteResolution removes a recorded conflict and its resolution from
+// rr-cache, so that rerere won't reuse it anymore
+func (self *RerereCommands) DeleteResolution(resolution *models.RerereResolution) error {
+	for _, name := range []string{"preimage", "postimage", "thisimage"} {
+		err := self.Fs.Remove(filepath.Join(resolution.Dir, name+resolution.Variant))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// Other variants may still be in there
+	if files, err := afero.ReadDir(self.Fs, resolution.Dir); err == nil && len(files) == 0 {
+		return self.Fs.Remove(resolution.Dir)
+	}
+
+	return nil
+}
+
+// DiffCmdObj shows how a recorded conflict was resolved
+func (self *RerereCommands) DiffCmdObj(resolution *models.RerereResolution) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--no-index", "--no-ext-diff").
+		Arg("--color="+self.pagerConfig.GetColorArg()).
+		Arg("--", resolution.PreimagePath(), resolution.PostimagePath()).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
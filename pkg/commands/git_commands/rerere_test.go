@@ -0,0 +1,121 @@
+package git_commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereIsEnabled(t *testing.T) {
+	scenarios := []struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		cacheDirExists         bool
+		expected               bool
+	}{
+		{
+			testName:               "not configured",
+			gitConfigMockResponses: nil,
+			cacheDirExists:         false,
+			expected:               false,
+		},
+		{
+			testName:               "not configured, but rr-cache exists",
+			gitConfigMockResponses: nil,
+			cacheDirExists:         true,
+			expected:               true,
+		},
+		{
+			testName:               "enabled",
+			gitConfigMockResponses: map[string]string{"rerere.enabled": "true"},
+			cacheDirExists:         false,
+			expected:               true,
+		},
+		{
+			testName:               "disabled although rr-cache exists",
+			gitConfigMockResponses: map[string]string{"rerere.enabled": "false"},
+			cacheDirExists:         true,
+			expected:               false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.cacheDirExists {
+				assert.NoError(t, fs.MkdirAll("/repo/.git/rr-cache", 0o755))
+			}
+			instance := buildRerereCommands(commonDeps{
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+				fs:        fs,
+				repoPaths: MockRepoPaths("/repo"),
+			})
+
+			assert.Equal(t, s.expected, instance.IsEnabled())
+		})
+	}
+}
+
+func TestRerereCommands(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rerere", "remaining"}, "file1\ndir/file2\n", nil).
+		ExpectGitArgs([]string{"rerere", "forget", "--", "file3"}, "", nil).
+		ExpectGitArgs([]string{"checkout", "-m", "--", "file3"}, "", nil)
+	instance := buildRerereCommands(commonDeps{runner: runner})
+
+	remaining, err := instance.Remaining()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"file1", "dir/file2"}, remaining)
+	assert.NoError(t, instance.Forget("file3"))
+	assert.NoError(t, instance.RecreateConflict("file3"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRerereGetResolutions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeFile := func(path string, content string, modTime time.Time) {
+		assert.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+		assert.NoError(t, fs.Chtimes(path, modTime, modTime))
+	}
+	preimage := "a\n<<<<<<<\n\n  ours\n=======\ntheirs\n>>>>>>>\n"
+	writeFile("/repo/.git/rr-cache/1111/preimage", preimage, time.Unix(100, 0))
+	writeFile("/repo/.git/rr-cache/1111/postimage", "a\nours\n", time.Unix(300, 0))
+	writeFile("/repo/.git/rr-cache/1111/preimage.1", "<<<<<<<\nother\n=======\n>>>>>>>\n", time.Unix(200, 0))
+	writeFile("/repo/.git/rr-cache/2222/preimage", "no conflict\n", time.Unix(400, 0))
+	writeFile("/repo/.git/rr-cache/2222/thisimage", "no conflict\n", time.Unix(400, 0))
+
+	instance := buildRerereCommands(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+	resolutions, err := instance.GetResolutions()
+	assert.NoError(t, err)
+	assert.Len(t, resolutions, 3)
+
+	assert.Equal(t, "2222", resolutions[0].ID())
+	assert.False(t, resolutions[0].Resolved)
+	assert.Equal(t, "", resolutions[0].Summary)
+
+	assert.Equal(t, "1111", resolutions[1].ID())
+	assert.True(t, resolutions[1].Resolved)
+	assert.Equal(t, "ours", resolutions[1].Summary)
+	assert.Equal(t, "/repo/.git/rr-cache/1111/postimage", resolutions[1].PostimagePath())
+
+	assert.Equal(t, "1111.1", resolutions[2].ID())
+	assert.False(t, resolutions[2].Resolved)
+	assert.Equal(t, "other", resolutions[2].Summary)
+
+	assert.NoError(t, instance.DeleteResolution(resolutions[1]))
+	exists, _ := afero.DirExists(fs, "/repo/.git/rr-cache/1111")
+	assert.True(t, exists)
+
+	assert.NoError(t, instance.DeleteResolution(resolutions[2]))
+	exists, _ = afero.DirExists(fs, "/repo/.git/rr-cache/1111")
+	assert.False(t, exists)
+
+	resolutions, err = instance.GetResolutions()
+	assert.NoError(t, err)
+	assert.Len(t, resolutions, 1)
+}
@@ -0,0 +1,112 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type SparseCheckoutCommands struct {
+	*GitCommon
+}
+
+func NewSparseCheckoutCommands(gitCommon *GitCommon) *SparseCheckoutCommands {
+	return &SparseCheckoutCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+func (self *SparseCheckoutCommands) IsEnabled() bool {
+	return self.config.GetSparseCheckout()
+}
+
+// IsConeMode returns true if the sparse checkout consists of directories
+// rather than gitignore-style patterns
+func (self *SparseCheckoutCommands) IsConeMode() bool {
+	return self.config.GetSparseCheckoutCone()
+}
+
+// List returns the directories of the sparse checkout in cone mode, or its
+// patterns in non-cone mode
+func (self *SparseCheckoutCommands) List() ([]string, error) {
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("list").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(strings.Split(output, "\n"), func(line string, _ int) bool {
+		return line != ""
+	}), nil
+}
+
+// Enable turns the working tree into a sparse checkout, containing only the
+// files at the top level of the repository
+func (self *SparseCheckoutCommands) Enable(cone bool) error {
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("init").
+		ArgIfElse(cone, "--cone", "--no-cone").
+		ToArgv()
+
+	defer self.config.DropConfigCache()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *SparseCheckoutCommands) Disable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("disable").
+		ToArgv()
+
+	defer self.config.DropConfigCache()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// SetConeMode switches between cone mode and non-cone mode, keeping the
+// current patterns
+func (self *SparseCheckoutCommands) SetConeMode(cone bool) error {
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("reapply").
+		ArgIfElse(cone, "--cone", "--no-cone").
+		ToArgv()
+
+	defer self.config.DropConfigCache()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Add adds directories (in cone mode) or patterns (in non-cone mode) to the
+// sparse checkout
+func (self *SparseCheckoutCommands) Add(patterns []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("add", "--stdin").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).SetStdin(patternsStdin(patterns)).Run()
+}
+
+// Remove removes directories (in cone mode) or patterns (in non-cone mode)
+// from the sparse checkout. Git has no command for this, so we set the
+// sparse checkout to the remaining entries.
+func (self *SparseCheckoutCommands) Remove(patterns []string) error {
+	current, err := self.List()
+	if err != nil {
+		return err
+	}
+
+	remaining := lo.Without(current, patterns...)
+	cmdArgs := NewGitCmd("sparse-checkout").
+		Arg("set", "--stdin").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).SetStdin(patternsStdin(remaining)).Run()
+}
+
+func patternsStdin(patterns []string) string {
+	if len(patterns) == 0 {
+		return ""
+	}
+
+	return strings.Join(patterns, "\n") + "\n"
+}
@@ -0,0 +1,106 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutIsEnabled(t *testing.T) {
+	scenarios := []struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		expectedEnabled        bool
+		expectedConeMode       bool
+	}{
+		{
+			testName:               "not enabled",
+			gitConfigMockResponses: nil,
+			expectedEnabled:        false,
+			expectedConeMode:       false,
+		},
+		{
+			testName:               "cone mode",
+			gitConfigMockResponses: map[string]string{"core.sparseCheckout": "true", "core.sparseCheckoutCone": "true"},
+			expectedEnabled:        true,
+			expectedConeMode:       true,
+		},
+		{
+			testName:               "non-cone mode",
+			gitConfigMockResponses: map[string]string{"core.sparseCheckout": "true", "core.sparseCheckoutCone": "false"},
+			expectedEnabled:        true,
+			expectedConeMode:       false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+			})
+
+			assert.Equal(t, s.expectedEnabled, instance.IsEnabled())
+			assert.Equal(t, s.expectedConeMode, instance.IsConeMode())
+		})
+	}
+}
+
+func TestSparseCheckoutList(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "list"}, "docs\nlib/core\n", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	patterns, err := instance.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docs", "lib/core"}, patterns)
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutRemove(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		patterns []string
+		runner   *oscommands.FakeCmdObjRunner
+	}{
+		{
+			testName: "some directories remain",
+			patterns: []string{"docs"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "docs\nlib/core\n", nil).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--stdin"}, "", nil),
+		},
+		{
+			testName: "no directories remain",
+			patterns: []string{"docs", "lib/core"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "docs\nlib/core\n", nil).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--stdin"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner})
+
+			assert.NoError(t, instance.Remove(s.patterns))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSparseCheckoutModes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "init", "--cone"}, "", nil).
+		ExpectGitArgs([]string{"sparse-checkout", "add", "--stdin"}, "", nil).
+		ExpectGitArgs([]string{"sparse-checkout", "reapply", "--no-cone"}, "", nil).
+		ExpectGitArgs([]string{"sparse-checkout", "disable"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Enable(true))
+	assert.NoError(t, instance.Add([]string{"docs"}))
+	assert.NoError(t, instance.SetConeMode(false))
+	assert.NoError(t, instance.Disable())
+	runner.CheckForMissingCalls()
+}
@@ -49,6 +49,26 @@ func (self *WorkingTreeCommands) StageFiles(paths []string, extraArgs []string)
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// HashFiles returns the object IDs that the current content of the given files
+// in the working tree would have, in the same order
+func (self *WorkingTreeCommands) HashFiles(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	cmdArgs := NewGitCmd("hash-object").
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(output), nil
+}
+
 // StageAll stages all files
 func (self *WorkingTreeCommands) StageAll(onlyTrackedFiles bool) error {
 	cmdArgs := NewGitCmd("add").
@@ -58,6 +78,16 @@ func (self *WorkingTreeCommands) StageAll(onlyTrackedFiles bool) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// ReadTreeIntoIndexFile creates an index file at the given path (or replaces
+// it) that contains the tree of the given ref. Together with
+// ApplyPatchOpts.IndexFile this allows building up a commit without touching
+// the repository's index.
+func (self *WorkingTreeCommands) ReadTreeIntoIndexFile(ref string, indexFile string) error {
+	cmdArgs := NewGitCmd("read-tree").Arg(ref).ToArgv()
+
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_INDEX_FILE=" + indexFile).Run()
+}
+
 // UnstageAll unstages all files
 func (self *WorkingTreeCommands) UnstageAll() error {
 	return self.cmd.New(NewGitCmd("reset").ToArgv()).Run()
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// A line of a file as shown by git blame, together with the commit in which
+// it was last changed
+type BlameLine struct {
+	Hash string
+	// The line number (1-based) and path of the line in the blamed commit; the
+	// path differs from the blamed file's path if the file was renamed, or if
+	// the line was moved or copied from another file
+	OriginalLineNumber int
+	OriginalPath       string
+	// The line number (1-based) in the blamed version of the file
+	LineNumber int
+
+	Author          string
+	AuthorEmail     string
+	AuthorTimestamp int64
+	Summary         string
+
+	// The commit and path that the line came from before the blamed commit
+	// changed it; empty for lines that were added in a root commit, or that
+	// aren't committed yet
+	PreviousHash string
+	PreviousPath string
+
+	Content string
+}
+
+func (self *BlameLine) ID() string {
+	return fmt.Sprintf("%d", self.LineNumber)
+}
+
+func (self *BlameLine) ShortHash() string {
+	return utils.ShortHash(self.Hash)
+}
+
+// Lines that were changed in the working tree are attributed to a commit hash
+// consisting of only zeros
+func (self *BlameLine) IsUncommitted() bool {
+	return strings.Trim(self.Hash, "0") == ""
+}
@@ -0,0 +1,53 @@
+package models
+
+import "github.com/samber/lo"
+
+// A chain of local branches, each of which is based on the previous one. The
+// bottom branch is based on a main branch.
+type BranchStack struct {
+	// From the bottom of the stack to the top
+	Branches []*StackBranch
+}
+
+type StackBranch struct {
+	Name string
+	// The branch that this one is based on: the previous branch of the stack,
+	// or, for the bottom branch, the full ref name of the main branch (empty
+	// if there is none)
+	Base string
+	// The commit where the branch forked off its base. If the base has moved
+	// on since, e.g. because commits were added to it or it was rebased, this
+	// is no longer the head of the base.
+	ForkPoint string
+	// The number of commits on top of the fork point
+	Ahead int
+	// The number of commits of the base that the branch doesn't have
+	Behind int
+}
+
+// Returns the branch with the given name and its index in the stack
+func (self *BranchStack) Find(name string) (*StackBranch, int, bool) {
+	if self == nil {
+		return nil, -1, false
+	}
+
+	return lo.FindIndexOf(self.Branches, func(b *StackBranch) bool { return b.Name == name })
+}
+
+func (self *BranchStack) Contains(name string) bool {
+	_, _, found := self.Find(name)
+	return found
+}
+
+// Returns the lowest branch that needs to be rebased onto its base
+func (self *BranchStack) FirstBranchNeedingRestack() (*StackBranch, bool) {
+	if self == nil {
+		return nil, false
+	}
+
+	return lo.Find(self.Branches, func(b *StackBranch) bool { return b.NeedsRestack() })
+}
+
+func (self *StackBranch) NeedsRestack() bool {
+	return self.Behind > 0 && self.Base != "" && self.ForkPoint != ""
+}
@@ -58,6 +58,18 @@ type Commit struct {
 	// commit; nil when not filtering by path.
 	FilterPaths []string
 
+	// Whether the commit is annotated by a note in any of the notes refs that
+	// we show
+	HasNotes bool
+
+	// The result of verifying the commit's signature; nil until it has been
+	// loaded, which we only do if the user wants to see signatures
+	Signature *CommitSignature
+
+	// The result of running the commit test command on the commit's tree; nil
+	// if it hasn't been tested
+	TestResult *CommitTestResult
+
 	Status     CommitStatus
 	Action     todo.TodoCommand
 	ActionFlag string     // e.g. "-C" for fixup -C
@@ -77,6 +89,9 @@ type NewCommitOpts struct {
 	UnixTimestamp int64
 	Divergence    Divergence
 	Parents       []string
+	HasNotes      bool
+	Signature     *CommitSignature
+	TestResult    *CommitTestResult
 }
 
 func NewCommit(hashPool *utils.StringPool, opts NewCommitOpts) *Commit {
@@ -92,6 +107,9 @@ func NewCommit(hashPool *utils.StringPool, opts NewCommitOpts) *Commit {
 		AuthorEmail:   opts.AuthorEmail,
 		UnixTimestamp: opts.UnixTimestamp,
 		Divergence:    opts.Divergence,
+		HasNotes:      opts.HasNotes,
+		Signature:     opts.Signature,
+		TestResult:    opts.TestResult,
 		parents:       lo.Map(opts.Parents, func(s string, _ int) *string { return hashPool.Add(s) }),
 	}
 }
@@ -5,6 +5,10 @@ type CommitFile struct {
 	Path string
 
 	ChangeStatus string // e.g. 'A' for added or 'M' for modified. This is based on the result from git diff --name-status
+
+	// Whether the file is tracked by Git LFS according to the repo's
+	// attributes files
+	IsLfs bool
 }
 
 func (f *CommitFile) ID() string {
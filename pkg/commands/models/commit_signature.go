@@ -0,0 +1,36 @@
+package models
+
+// The result of verifying a commit's signature, as reported by git's %G?
+// placeholder
+type SignatureStatus uint8
+
+const (
+	// The commit isn't signed
+	SignatureNone SignatureStatus = iota
+	// Good signature by a key that is trusted (GPG) or listed in the allowed
+	// signers file (SSH)
+	SignatureGood
+	// Good signature, but we can't tell whether the key belongs to the signer
+	SignatureUntrusted
+	// Good signature, but the signature or the key has expired
+	SignatureExpired
+	// Good signature by a key that has been revoked
+	SignatureRevoked
+	// The signature doesn't match the commit
+	SignatureBad
+	// The signature can't be checked, e.g. because the key is missing
+	SignatureUnverifiable
+)
+
+type CommitSignature struct {
+	Status SignatureStatus
+	// The key that made the signature, e.g. a GPG key ID or an SSH key's
+	// fingerprint
+	Key string
+	// Who the key belongs to, if known
+	Signer string
+}
+
+func (s *CommitSignature) IsSigned() bool {
+	return s.Status != SignatureNone
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type CommitTestStatus string
+
+const (
+	CommitTestRunning CommitTestStatus = "running"
+	CommitTestPassed  CommitTestStatus = "passed"
+	CommitTestFailed  CommitTestStatus = "failed"
+)
+
+// The outcome of running a test command in a temporary worktree of a commit.
+// Results are keyed by the commit's tree hash, so a commit that is reworded or
+// rebased without changing its content doesn't need to be tested again.
+type CommitTestResult struct {
+	Status CommitTestStatus `yaml:"status"`
+	// The combined stdout and stderr of the command
+	Output   string    `yaml:"output,omitempty"`
+	TestedAt time.Time `yaml:"testedAt"`
+}
+
+func (r *CommitTestResult) Failed() bool {
+	return r.Status == CommitTestFailed
+}
+
+// All test results of a repo, as stored in its git dir
+type CommitTestResults struct {
+	// The command that was run most recently; the commits panel shows the
+	// results of this one
+	LastCommand string `yaml:"lastCommand"`
+	// Results by command, then by tree hash
+	Results map[string]map[string]*CommitTestResult `yaml:"results"`
+}
+
+// Results of the given command by tree hash
+func (r *CommitTestResults) ForCommand(command string) map[string]*CommitTestResult {
+	if r.Results == nil {
+		r.Results = map[string]map[string]*CommitTestResult{}
+	}
+	if r.Results[command] == nil {
+		r.Results[command] = map[string]*CommitTestResult{}
+	}
+
+	return r.Results[command]
+}
@@ -25,6 +25,20 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// Whether the file is tracked by Git LFS according to the repo's
+	// attributes files
+	IsLfs bool
+
+	// If true, the file had merge conflicts that git rerere resolved
+	// automatically by reusing a recorded resolution
+	IsRerereResolved bool
+
+	// If true, the file has the skip-worktree bit set, so it isn't checked out
+	// in the working tree, e.g. because it's outside of the sparse checkout.
+	// Only set for files returned by FileLoader.GetTrackedFiles, and for status
+	// files in a sparse checkout.
+	IsSkipWorktree bool
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
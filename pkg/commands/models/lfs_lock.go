@@ -0,0 +1,9 @@
+package models
+
+// LfsLock is a Git LFS file lock, as held on the LFS server
+type LfsLock struct {
+	ID       string
+	Path     string
+	Owner    string
+	LockedAt string // e.g. "2016-05-17T15:49:06+00:00"
+}
@@ -0,0 +1,50 @@
+package models
+
+import "fmt"
+
+// How a commit of the old range of a range-diff relates to its counterpart in
+// the new range
+type RangeDiffStatus int
+
+const (
+	// The two commits have the same patch ("=" in git range-diff's output)
+	RangeDiffIdentical RangeDiffStatus = iota
+	// The two commits correspond to each other, but their patches or messages
+	// differ ("!")
+	RangeDiffModified
+	// The commit only exists in the old range ("<")
+	RangeDiffRemoved
+	// The commit only exists in the new range (">")
+	RangeDiffAdded
+)
+
+// A pair of corresponding commits in the two ranges compared by git
+// range-diff. For commits that only exist in one of the ranges, the index and
+// hash of the other side are empty.
+type RangeDiffPair struct {
+	Status RangeDiffStatus
+	// 1-based positions of the commits in their ranges, or 0
+	OldIndex int
+	NewIndex int
+	// Abbreviated hashes, as printed by git range-diff
+	OldHash string
+	NewHash string
+	Subject string
+	// What changed between the two commits, as a diff of their patches; only
+	// set for modified pairs
+	Interdiff string
+}
+
+func (self *RangeDiffPair) ID() string {
+	return fmt.Sprintf("%d:%d", self.OldIndex, self.NewIndex)
+}
+
+// The hash of the commit to show for this pair: the new one, unless the commit
+// only exists in the old range
+func (self *RangeDiffPair) Hash() string {
+	if self.Status == RangeDiffRemoved {
+		return self.OldHash
+	}
+
+	return self.NewHash
+}
@@ -0,0 +1,39 @@
+package models
+
+// Statistics about the repository's object database, as reported by git
+// count-objects. Sizes are in bytes.
+type ObjectCounts struct {
+	LooseObjects  int
+	LooseSize     int64
+	PackedObjects int
+	Packs         int
+	PackSize      int64
+	// Loose objects that are also in a pack, and could therefore be pruned
+	PrunePackable int
+	// Files in the object database that are neither valid loose objects nor
+	// valid packs
+	Garbage     int
+	GarbageSize int64
+}
+
+// A snapshot of the things that tell whether a repository needs maintenance
+type RepoHealth struct {
+	// Nil if we couldn't count the objects
+	Objects *ObjectCounts
+	// Whether git has written a commit-graph file, which speeds up walking
+	// the history
+	HasCommitGraph bool
+	// Whether the repo is registered for git's background maintenance
+	MaintenanceRegistered bool
+	// Worktrees whose directory doesn't exist anymore
+	StaleWorktrees []*Worktree
+	// Branches whose upstream branch was deleted on the remote
+	GoneUpstreamBranches []*Branch
+	// Untracked files that are larger than we'd expect to be committed
+	LargeUntrackedFiles []*LargeFile
+}
+
+type LargeFile struct {
+	Path string
+	Size int64
+}
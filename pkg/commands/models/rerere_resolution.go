@@ -0,0 +1,45 @@
+package models
+
+import (
+	"path/filepath"
+	"time"
+)
+
+// A conflict that git rerere has recorded in the rr-cache directory, together
+// with its resolution if it has one. Rerere identifies conflicts by a hash of
+// their conflicting hunks, so it doesn't know which file a resolution belongs
+// to.
+type RerereResolution struct {
+	// Name of the conflict's directory in rr-cache
+	Hash string
+	// Suffix of the preimage and postimage files, e.g. ".1", for conflicts
+	// whose hunks have the same hash as those of another conflict. Empty for
+	// the first one.
+	Variant string
+	// Path of the conflict's directory in rr-cache
+	Dir string
+	// False if the conflict was recorded but its resolution wasn't yet
+	Resolved bool
+	// When the resolution (or, if there is none, the conflict) was recorded
+	RecordedAt time.Time
+	// The first line of the first conflicting hunk, to tell conflicts apart
+	Summary string
+}
+
+func (r *RerereResolution) ID() string {
+	return r.Hash + r.Variant
+}
+
+func (r *RerereResolution) Description() string {
+	return r.Summary
+}
+
+// The conflicted file, with normalized conflict markers
+func (r *RerereResolution) PreimagePath() string {
+	return filepath.Join(r.Dir, "preimage"+r.Variant)
+}
+
+// The resolved file. Only exists if Resolved is true.
+func (r *RerereResolution) PostimagePath() string {
+	return filepath.Join(r.Dir, "postimage"+r.Variant)
+}
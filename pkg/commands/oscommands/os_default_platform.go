@@ -47,3 +47,18 @@ func TerminateProcessGracefully(cmd *exec.Cmd) error {
 
 	return cmd.Process.Signal(syscall.SIGTERM)
 }
+
+// StartInNewProcessGroup makes the command start in a process group of its
+// own, so that TerminateProcessGroup can stop it together with the processes
+// that it starts
+func StartInNewProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func TerminateProcessGroup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+}
@@ -3,7 +3,10 @@
 package oscommands
 
 import (
+	"os/exec"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/stretchr/testify/assert"
@@ -139,3 +142,28 @@ func TestOSCommandOpenFileLinux(t *testing.T) {
 		s.test(oSCmd.OpenFile(s.filename))
 	}
 }
+
+func TestTerminateProcessGroup(t *testing.T) {
+	// the shell waits for the sleep, which is what a test command run by
+	// 'git bisect run' looks like
+	cmd := exec.Command("sh", "-c", "sleep 30; true")
+	StartInNewProcessGroup(cmd)
+	assert.NoError(t, cmd.Start())
+
+	done := make(chan error)
+	go func() { done <- cmd.Wait() }()
+
+	assert.NoError(t, TerminateProcessGroup(cmd))
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("process group was not terminated")
+	}
+
+	// the sleep must be gone as well, so the group can't be signalled anymore
+	assert.Eventually(t, func() bool {
+		return syscall.Kill(-cmd.Process.Pid, 0) == syscall.ESRCH
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"syscall"
 )
 
 func GetPlatform() *Platform {
@@ -28,3 +30,20 @@ func TerminateProcessGracefully(cmd *exec.Cmd) error {
 	// Signals other than SIGKILL are not supported on Windows
 	return nil
 }
+
+// StartInNewProcessGroup makes the command start in a process group of its
+// own, so that TerminateProcessGroup can stop it together with the processes
+// that it starts
+func StartInNewProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// There are no signals for process groups on Windows, so we kill the whole
+// process tree instead
+func TerminateProcessGroup(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+}
@@ -1,6 +1,8 @@
 package patch
 
 import (
+	"strings"
+
 	"github.com/samber/lo"
 )
 
@@ -104,6 +106,43 @@ func (self *Patch) LineNumberOfLine(idx int) int {
 	return hunk.newStart + offset
 }
 
+// Takes a range of line indices in the patch (inclusive) and returns the first
+// and last line numbers that its lines have in the old file, or in the new file
+// if newFile is true. Lines that don't exist in that file (e.g. additions when
+// asking for the old file) are ignored; if the range only consists of such
+// lines, both return values are the number of the line that precedes them.
+func (self *Patch) LineRangeOfLines(startIdx int, endIdx int, newFile bool) (int, int) {
+	existingKind := lo.Ternary(newFile, ADDITION, DELETION)
+	first, last := -1, -1
+	preceding := 1
+	for hunkIdx, hunk := range self.hunks {
+		idx := self.HunkStartIdx(hunkIdx) + 1
+		lineNumber := lo.Ternary(newFile, hunk.newStart, hunk.oldStart)
+		for _, line := range hunk.bodyLines {
+			exists := line.Kind == CONTEXT || line.Kind == existingKind
+			if idx >= startIdx && idx <= endIdx {
+				if exists {
+					if first == -1 {
+						first = lineNumber
+					}
+					last = lineNumber
+				} else if first == -1 {
+					preceding = max(lineNumber-1, 1)
+				}
+			}
+			if exists {
+				lineNumber++
+			}
+			idx++
+		}
+	}
+
+	if first == -1 {
+		return preceding, preceding
+	}
+	return first, last
+}
+
 // Returns hunk index containing the line at the given patch line index
 func (self *Patch) HunkContainingLine(idx int) int {
 	for hunkIdx, hunk := range self.hunks {
@@ -115,6 +154,15 @@ func (self *Patch) HunkContainingLine(idx int) int {
 	return -1
 }
 
+// Returns the text that git shows after the header of the given hunk, which is
+// usually the line that starts the function that the hunk is in
+func (self *Patch) HunkHeaderContext(hunkIdx int) string {
+	if hunkIdx < 0 || hunkIdx >= len(self.hunks) {
+		return ""
+	}
+	return strings.TrimSpace(self.hunks[hunkIdx].headerContext)
+}
+
 // Returns the patch line index of the next change (i.e. addition or deletion)
 // that matches the same "included" state, given the includedLines. If you don't
 // care about included states, pass nil for includedLines and false for included.
@@ -200,3 +248,42 @@ func (self *Patch) IsSingleHunkForWholeFile() bool {
 	return nLinesWithKind(bodyLines, []PatchLineKind{DELETION, CONTEXT}) == 0 ||
 		nLinesWithKind(bodyLines, []PatchLineKind{ADDITION, CONTEXT}) == 0
 }
+
+// Returns the line number where the given hunk starts in the old file, and the
+// number of lines it spans there. For a hunk of a diff with zero context lines
+// that only adds lines, the length is 0 and the start is the number of the line
+// after which the lines are added.
+func (self *Patch) HunkOldRange(hunkIdx int) (int, int) {
+	hunk := self.hunks[hunkIdx]
+	return hunk.oldStart, hunk.oldLength()
+}
+
+// Returns a new patch that only contains the hunks with the given indices, to
+// be applied to a version of the old file that already has the hunks in
+// appliedHunkIndices applied. The line numbers of the hunk headers are adjusted
+// accordingly. This requires that the hunks don't overlap, which is always the
+// case for a diff with zero context lines.
+func (self *Patch) SelectHunks(hunkIndices []int, appliedHunkIndices []int) *Patch {
+	hunks := []*Hunk{}
+	// Offsets caused by the preceding hunks that are already applied, or that
+	// are applied by the new patch, or that are in the original patch
+	appliedOffset, resultOffset, originalOffset := 0, 0, 0
+	for i, hunk := range self.hunks {
+		delta := hunk.newLength() - hunk.oldLength()
+		if lo.Contains(hunkIndices, i) {
+			hunks = append(hunks, &Hunk{
+				oldStart:      hunk.oldStart + appliedOffset,
+				newStart:      hunk.newStart - originalOffset + resultOffset,
+				headerContext: hunk.headerContext,
+				bodyLines:     hunk.bodyLines,
+			})
+			resultOffset += delta
+		} else if lo.Contains(appliedHunkIndices, i) {
+			appliedOffset += delta
+			resultOffset += delta
+		}
+		originalOffset += delta
+	}
+
+	return &Patch{header: self.header, hunks: hunks}
+}
@@ -627,6 +627,92 @@ func TestLineNumberOfLine(t *testing.T) {
 	}
 }
 
+func TestLineRangeOfLines(t *testing.T) {
+	type scenario struct {
+		testName      string
+		startIdx      int
+		endIdx        int
+		newFile       bool
+		expectedFirst int
+		expectedLast  int
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "deletion and addition in old file",
+			startIdx:      5,
+			endIdx:        7,
+			newFile:       false,
+			expectedFirst: 1,
+			expectedLast:  2,
+		},
+		{
+			testName:      "deletion and addition in new file",
+			startIdx:      5,
+			endIdx:        7,
+			newFile:       true,
+			expectedFirst: 1,
+			expectedLast:  2,
+		},
+		{
+			testName:      "only a deletion in new file",
+			startIdx:      6,
+			endIdx:        6,
+			newFile:       true,
+			expectedFirst: 1,
+			expectedLast:  1,
+		},
+		{
+			testName:      "only additions in old file",
+			startIdx:      15,
+			endIdx:        16,
+			newFile:       false,
+			expectedFirst: 10,
+			expectedLast:  10,
+		},
+		{
+			testName:      "only additions in new file",
+			startIdx:      15,
+			endIdx:        16,
+			newFile:       true,
+			expectedFirst: 11,
+			expectedLast:  12,
+		},
+		{
+			testName:      "context and additions in old file",
+			startIdx:      12,
+			endIdx:        17,
+			newFile:       false,
+			expectedFirst: 8,
+			expectedLast:  11,
+		},
+		{
+			testName:      "context and additions in new file",
+			startIdx:      12,
+			endIdx:        17,
+			newFile:       true,
+			expectedFirst: 8,
+			expectedLast:  13,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(twoHunks)
+			first, last := patch.LineRangeOfLines(s.startIdx, s.endIdx, s.newFile)
+			assert.Equal(t, s.expectedFirst, first)
+			assert.Equal(t, s.expectedLast, last)
+		})
+	}
+}
+
+func TestHunkHeaderContext(t *testing.T) {
+	patch := Parse(addNewlineToEndOfFile)
+	assert.Equal(t, "grape", patch.HunkHeaderContext(0))
+	assert.Equal(t, "", patch.HunkHeaderContext(-1))
+	assert.Equal(t, "", patch.HunkHeaderContext(1))
+}
+
 func TestGetNextStageableLineIndex(t *testing.T) {
 	type scenario struct {
 		testName  string
@@ -771,3 +857,103 @@ func TestIsSingleHunkForWholeFile(t *testing.T) {
 		})
 	}
 }
+
+const zeroContextHunks = `diff --git a/filename b/filename
+index 8e1ba5c..3f52b2c 100644
+--- a/filename
++++ b/filename
+@@ -2 +2,2 @@ apple
+-grape
++orange
++pear
+@@ -5,0 +7 @@ banana
++mango
+@@ -8,2 +9,0 @@ cherry
+-plum
+-kiwi
+`
+
+func TestHunkOldRange(t *testing.T) {
+	patch := Parse(zeroContextHunks)
+	for i, expected := range [][2]int{{2, 1}, {5, 0}, {8, 2}} {
+		start, length := patch.HunkOldRange(i)
+		assert.Equal(t, expected, [2]int{start, length})
+	}
+}
+
+func TestSelectHunks(t *testing.T) {
+	scenarios := []struct {
+		testName           string
+		hunkIndices        []int
+		appliedHunkIndices []int
+		expected           string
+	}{
+		{
+			testName:    "single hunk, nothing applied",
+			hunkIndices: []int{1},
+			expected: `diff --git a/filename b/filename
+index 8e1ba5c..3f52b2c 100644
+--- a/filename
++++ b/filename
+@@ -5,0 +6 @@ banana
++mango
+`,
+		},
+		{
+			testName:           "single hunk after an applied hunk",
+			hunkIndices:        []int{2},
+			appliedHunkIndices: []int{0},
+			expected: `diff --git a/filename b/filename
+index 8e1ba5c..3f52b2c 100644
+--- a/filename
++++ b/filename
+@@ -9,2 +8,0 @@ cherry
+-plum
+-kiwi
+`,
+		},
+		{
+			testName:           "hunks around an applied hunk",
+			hunkIndices:        []int{0, 2},
+			appliedHunkIndices: []int{1},
+			expected: `diff --git a/filename b/filename
+index 8e1ba5c..3f52b2c 100644
+--- a/filename
++++ b/filename
+@@ -2,1 +2,2 @@ apple
+-grape
++orange
++pear
+@@ -9,2 +9,0 @@ cherry
+-plum
+-kiwi
+`,
+		},
+		{
+			testName:           "all hunks",
+			hunkIndices:        []int{0, 1, 2},
+			appliedHunkIndices: nil,
+			expected: `diff --git a/filename b/filename
+index 8e1ba5c..3f52b2c 100644
+--- a/filename
++++ b/filename
+@@ -2,1 +2,2 @@ apple
+-grape
++orange
++pear
+@@ -5,0 +7 @@ banana
++mango
+@@ -8,2 +9,0 @@ cherry
+-plum
+-kiwi
+`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			patch := Parse(zeroContextHunks)
+			assert.Equal(t, s.expected, patch.SelectHunks(s.hunkIndices, s.appliedHunkIndices).FormatPlain())
+		})
+	}
+}
@@ -704,6 +704,10 @@ type AppState struct {
 	ShellCommandsHistory []string `yaml:"customcommandshistory"`
 
 	HideCommandLog bool
+
+	// Name of the commit message generator that was used last, so that it can
+	// be preselected the next time
+	LastCommitGenerator string
 }
 
 func getDefaultAppState() *AppState {
@@ -162,6 +162,9 @@ type GuiConfig struct {
 	CommitHashLength int `yaml:"commitHashLength" jsonschema:"minimum=0"`
 	// If true, show commit hashes alongside branch names in the branches view.
 	ShowBranchCommitHash bool `yaml:"showBranchCommitHash"`
+	// If true, verify the signatures of the commits in the commits views and show the result next to each commit, and show git's verification output above the commit's diff. Verifying signatures is slow, so it happens in the background after the commits have been loaded.
+	// For SSH signatures, git needs gpg.ssh.allowedSignersFile to tell who made them.
+	ShowCommitSignatures bool `yaml:"showCommitSignatures"`
 	// Whether to show the divergence from the base branch in the branches view.
 	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber'
 	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber"`
@@ -188,8 +191,8 @@ type GuiConfig struct {
 	// Config relating to the spinner.
 	Spinner SpinnerConfig `yaml:"spinner"`
 	// Status panel view.
-	// One of 'dashboard' (default) | 'allBranchesLog'
-	StatusPanelView string `yaml:"statusPanelView" jsonschema:"enum=dashboard,enum=allBranchesLog"`
+	// One of 'dashboard' (default) | 'allBranchesLog' | 'health'
+	StatusPanelView string `yaml:"statusPanelView" jsonschema:"enum=dashboard,enum=allBranchesLog,enum=health"`
 	// If true, jump to the Files panel after popping a stash
 	SwitchToFilesAfterStashPop bool `yaml:"switchToFilesAfterStashPop"`
 	// If true, jump to the Files panel after applying a stash
@@ -233,6 +236,8 @@ type ThemeConfig struct {
 type CommitLengthConfig struct {
 	// If true, show an indicator of commit message length
 	Show bool `yaml:"show"`
+	// Maximum length of a generated commit summary; you are warned about longer ones. 0 means no limit.
+	Max int `yaml:"max" jsonschema:"minimum=0"`
 }
 
 type SpinnerConfig struct {
@@ -321,6 +326,12 @@ type GitConfig struct {
 	RemoteBranchSortOrder string `yaml:"remoteBranchSortOrder" jsonschema:"enum=date,enum=alphabetical"`
 	// When copying commit hashes to the clipboard, truncate them to this length. Set to 40 to disable truncation.
 	TruncateCopiedCommitHashesTo int `yaml:"truncateCopiedCommitHashesTo"`
+	// Notes refs to show in addition to the default one (git's `core.notesRef`, or `refs/notes/commits` if unset), e.g. 'refs/notes/review'. The 'refs/notes/' prefix can be omitted.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#git-notes
+	NotesRefs []string `yaml:"notesRefs"`
+	// Shell command to run on each commit when testing commits from the commits panel, e.g. 'make test'. It runs in a temporary worktree of the commit; exit code 0 means the commit passed. If empty, lazygit asks for the command.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#testing-commits
+	CommitTestCommand string `yaml:"commitTestCommand"`
 }
 
 type PagerType string
@@ -355,6 +366,77 @@ type CommitConfig struct {
 	AutoWrapCommitMessage bool `yaml:"autoWrapCommitMessage"`
 	// If autoWrapCommitMessage is true, the width to wrap to
 	AutoWrapWidth int `yaml:"autoWrapWidth"`
+	// Config for the commit message generators offered by the Generate button in the commit panel
+	Generator CommitGeneratorConfig `yaml:"generator"`
+	// Rules that commit messages are checked against while you type them. Committing a message that breaks any of them asks for confirmation first.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#commit-message-linting
+	Lint CommitLintConfig `yaml:"lint"`
+	// Trailer keys offered when adding a trailer to a commit message, e.g. in the commit menu of the commit panel. Any other key can be entered too.
+	// Keys ending in '-by' get suggestions from the authors of the repo's commits.
+	TrailerKeys []string `yaml:"trailerKeys"`
+	// Named commit message templates offered by the templates menu of the commit panel, in addition to the file configured in git's `commit.template`.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#commit-message-templates
+	Templates []CommitTemplate `yaml:"templates"`
+	// Regular expression that finds the ticket ID in the branch name, for the {{.TicketID}} placeholder of commit message templates. If it has a capture group, the first one is used, otherwise the whole match.
+	TicketIDPattern string `yaml:"ticketIdPattern"`
+	// Number of recent commit messages (from all branches) that the commit message history picker offers
+	HistoryPickerSize int `yaml:"historyPickerSize" jsonschema:"minimum=1"`
+}
+
+type CommitTemplate struct {
+	// The name to show in the templates menu
+	Name string `yaml:"name"`
+	// The message, using Go template syntax for the placeholders {{.BranchName}}, {{.TicketID}} and {{.StagedFiles}} (a list of paths). The first line is the summary.
+	Message string `yaml:"message"`
+}
+
+type CommitLintConfig struct {
+	// Allowed conventional-commit types, e.g. [feat, fix, docs]. If non-empty, the summary must have the form 'type: subject' or 'type(scope): subject'.
+	Types []string `yaml:"types"`
+	// Allowed conventional-commit scopes. If non-empty, a scope must be one of these if one is given.
+	Scopes []string `yaml:"scopes"`
+	// Maximum length of the summary. 0 means no limit.
+	MaxSummaryLength int `yaml:"maxSummaryLength" jsonschema:"minimum=0"`
+	// If true, complain about summaries that don't seem to be written in the imperative mood, e.g. 'Fixed crash' or 'Adds option'
+	ImperativeMood bool `yaml:"imperativeMood"`
+	// Trailers that every commit message must have
+	RequiredTrailers []CommitLintTrailer `yaml:"requiredTrailers"`
+}
+
+type CommitLintTrailer struct {
+	// The trailer key, e.g. 'Signed-off-by'
+	Key string `yaml:"key" jsonschema:"example=Signed-off-by,example=Refs"`
+	// A regular expression that the trailer's value must match, e.g. '^[A-Z]+-\d+$' for a ticket ID. Empty means any value.
+	ValuePattern string `yaml:"valuePattern"`
+}
+
+// IsEnabled returns true if any lint rule is configured.
+func (c *CommitLintConfig) IsEnabled() bool {
+	return len(c.Types) > 0 || len(c.Scopes) > 0 || c.MaxSummaryLength > 0 || c.ImperativeMood || len(c.RequiredTrailers) > 0
+}
+
+type CommitGeneratorConfig struct {
+	// List of named commit message generators. If more than one is configured, pressing the Generate button shows a menu to pick one, and the choice is remembered for next time.
+	// If the list is empty, the bundled `zeemux llm commit-msg` command is used.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#commit-message-generators
+	Commands []CommitGeneratorCommand `yaml:"commands"`
+	// Maximum size in bytes of the staged diff that is passed to a generator (roughly 4 bytes per token). Larger diffs are shrunk by leaving out binary files first, then files matching lowPriorityFiles, and finally by truncating the remaining files. 0 means no limit.
+	MaxDiffBytes int `yaml:"maxDiffBytes" jsonschema:"minimum=0"`
+	// Glob patterns (matched against the path or the file name) of files whose diff is left out first when the diff exceeds maxDiffBytes
+	LowPriorityFiles []string `yaml:"lowPriorityFiles"`
+}
+
+type CommitGeneratorCommand struct {
+	// The name to show in the generator menu
+	Name string `yaml:"name"`
+	// The command to run (using Go template syntax for placeholder values, like custom commands). It receives the staged diff, the checked-out branch and recent commit subjects as JSON on stdin, and must print the commit message to stdout, either as plain text or as a JSON object with "summary" and "description" fields.
+	Command string `yaml:"command" jsonschema:"example=my-llm-tool commit-message --repo {{.RepoPath | quote}}"`
+	// Number of seconds after which the generator is stopped. 0 means no timeout.
+	Timeout int `yaml:"timeout" jsonschema:"minimum=0"`
+}
+
+func (c *CommitGeneratorCommand) TimeoutDuration() time.Duration {
+	return time.Second * time.Duration(c.Timeout)
 }
 
 type MergingConfig struct {
@@ -409,6 +491,7 @@ type KeybindingConfig struct {
 	Main           KeybindingMainConfig           `yaml:"main"`
 	Submodules     KeybindingSubmodulesConfig     `yaml:"submodules"`
 	CommitMessage  KeybindingCommitMessageConfig  `yaml:"commitMessage"`
+	Blame          KeybindingBlameConfig          `yaml:"blame"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -496,28 +579,37 @@ type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	RepoHealth          string `yaml:"repoHealth"`
+	RepoMaintenance     string `yaml:"repoMaintenance"`
 }
 
 type KeybindingFilesConfig struct {
-	CommitChanges            string `yaml:"commitChanges"`
-	CommitChangesWithoutHook string `yaml:"commitChangesWithoutHook"`
-	AmendLastCommit          string `yaml:"amendLastCommit"`
-	CommitChangesWithEditor  string `yaml:"commitChangesWithEditor"`
-	FindBaseCommitForFixup   string `yaml:"findBaseCommitForFixup"`
-	ConfirmDiscard           string `yaml:"confirmDiscard"`
-	IgnoreFile               string `yaml:"ignoreFile"`
-	RefreshFiles             string `yaml:"refreshFiles"`
-	StashAllChanges          string `yaml:"stashAllChanges"`
-	ViewStashOptions         string `yaml:"viewStashOptions"`
-	ToggleStagedAll          string `yaml:"toggleStagedAll"`
-	ViewResetOptions         string `yaml:"viewResetOptions"`
-	Fetch                    string `yaml:"fetch"`
-	ToggleTreeView           string `yaml:"toggleTreeView"`
-	OpenMergeOptions         string `yaml:"openMergeOptions"`
-	OpenStatusFilter         string `yaml:"openStatusFilter"`
-	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
-	CollapseAll              string `yaml:"collapseAll"`
-	ExpandAll                string `yaml:"expandAll"`
+	CommitChanges             string `yaml:"commitChanges"`
+	CommitChangesWithoutHook  string `yaml:"commitChangesWithoutHook"`
+	AmendLastCommit           string `yaml:"amendLastCommit"`
+	CommitChangesWithEditor   string `yaml:"commitChangesWithEditor"`
+	RunPreCommitHooks         string `yaml:"runPreCommitHooks"`
+	FindBaseCommitForFixup    string `yaml:"findBaseCommitForFixup"`
+	AbsorbStagedChanges       string `yaml:"absorbStagedChanges"`
+	ConfirmDiscard            string `yaml:"confirmDiscard"`
+	IgnoreFile                string `yaml:"ignoreFile"`
+	RefreshFiles              string `yaml:"refreshFiles"`
+	StashAllChanges           string `yaml:"stashAllChanges"`
+	ViewStashOptions          string `yaml:"viewStashOptions"`
+	ToggleStagedAll           string `yaml:"toggleStagedAll"`
+	ViewResetOptions          string `yaml:"viewResetOptions"`
+	Fetch                     string `yaml:"fetch"`
+	ToggleTreeView            string `yaml:"toggleTreeView"`
+	OpenMergeOptions          string `yaml:"openMergeOptions"`
+	OpenStatusFilter          string `yaml:"openStatusFilter"`
+	CopyFileInfoToClipboard   string `yaml:"copyFileInfoToClipboard"`
+	CollapseAll               string `yaml:"collapseAll"`
+	ExpandAll                 string `yaml:"expandAll"`
+	OpenBlame                 string `yaml:"openBlame"`
+	ViewLfsOptions            string `yaml:"viewLfsOptions"`
+	ViewSparseCheckoutOptions string `yaml:"viewSparseCheckoutOptions"`
+	ApplyMailbox              string `yaml:"applyMailbox"`
+	ViewRerereOptions         string `yaml:"viewRerereOptions"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -539,6 +631,7 @@ type KeybindingBranchesConfig struct {
 	FetchRemote            string `yaml:"fetchRemote"`
 	AddForkRemote          string `yaml:"addForkRemote"`
 	SortOrder              string `yaml:"sortOrder"`
+	ViewStackOptions       string `yaml:"viewStackOptions"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -549,6 +642,7 @@ type KeybindingCommitsConfig struct {
 	SquashDown                     string `yaml:"squashDown"`
 	RenameCommit                   string `yaml:"renameCommit"`
 	RenameCommitWithEditor         string `yaml:"renameCommitWithEditor"`
+	RegenerateCommitMessage        string `yaml:"regenerateCommitMessage"`
 	ViewResetOptions               string `yaml:"viewResetOptions"`
 	MarkCommitAsFixup              string `yaml:"markCommitAsFixup"`
 	SetFixupMessage                string `yaml:"setFixupMessage"`
@@ -572,12 +666,20 @@ type KeybindingCommitsConfig struct {
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
 	SelectCommitsOfCurrentBranch   string `yaml:"selectCommitsOfCurrentBranch"`
+	ViewNotesOptions               string `yaml:"viewNotesOptions"`
+	FetchLfsObjects                string `yaml:"fetchLfsObjects"`
+	ViewRangeDiffOptions           string `yaml:"viewRangeDiffOptions"`
+	ExportPatches                  string `yaml:"exportPatches"`
+	ViewTestOptions                string `yaml:"viewTestOptions"`
+	ViewRebaseTodoOptions          string `yaml:"viewRebaseTodoOptions"`
+	ViewStackOptions               string `yaml:"viewStackOptions"`
 }
 
 type KeybindingAmendAttributeConfig struct {
-	ResetAuthor string `yaml:"resetAuthor"`
-	SetAuthor   string `yaml:"setAuthor"`
-	AddCoAuthor string `yaml:"addCoAuthor"`
+	ResetAuthor  string `yaml:"resetAuthor"`
+	SetAuthor    string `yaml:"setAuthor"`
+	AddCoAuthor  string `yaml:"addCoAuthor"`
+	EditTrailers string `yaml:"editTrailers"`
 }
 
 type KeybindingStashConfig struct {
@@ -605,6 +707,13 @@ type KeybindingCommitMessageConfig struct {
 	CommitMenu string `yaml:"commitMenu"`
 }
 
+type KeybindingBlameConfig struct {
+	BlameParentCommit      string `yaml:"blameParentCommit"`
+	ToggleIgnoreWhitespace string `yaml:"toggleIgnoreWhitespace"`
+	ToggleDetectMoves      string `yaml:"toggleDetectMoves"`
+	ToggleDetectCopies     string `yaml:"toggleDetectCopies"`
+}
+
 // OSConfig contains config on the level of the os
 type OSConfig struct {
 	// Command for editing a file. Should contain "{{filename}}".
@@ -786,7 +895,7 @@ func GetDefaultConfig() *UserConfig {
 				UnstagedChangesColor:            []string{"red"},
 				DefaultFgColor:                  []string{"default"},
 			},
-			CommitLength:                        CommitLengthConfig{Show: true},
+			CommitLength:                        CommitLengthConfig{Show: true, Max: 0},
 			SkipNoStagedFilesWarning:            false,
 			ShowListFooter:                      true,
 			ShowCommandLog:                      true,
@@ -803,6 +912,7 @@ func GetDefaultConfig() *UserConfig {
 			CommitAuthorLongLength:              17,
 			CommitHashLength:                    8,
 			ShowBranchCommitHash:                false,
+			ShowCommitSignatures:                false,
 			ShowDivergenceFromBaseBranch:        "none",
 			CommandLogSize:                      8,
 			SplitDiff:                           "auto",
@@ -827,6 +937,22 @@ func GetDefaultConfig() *UserConfig {
 				SignOff:               false,
 				AutoWrapCommitMessage: true,
 				AutoWrapWidth:         72,
+				Generator: CommitGeneratorConfig{
+					Commands:         []CommitGeneratorCommand(nil),
+					MaxDiffBytes:     100000,
+					LowPriorityFiles: []string{"*.lock", "package-lock.json", "pnpm-lock.yaml", "go.sum"},
+				},
+				Lint: CommitLintConfig{
+					Types:            []string(nil),
+					Scopes:           []string(nil),
+					MaxSummaryLength: 0,
+					ImperativeMood:   false,
+					RequiredTrailers: []CommitLintTrailer(nil),
+				},
+				TrailerKeys:       []string{"Co-authored-by", "Reviewed-by", "Signed-off-by", "Fixes"},
+				Templates:         []CommitTemplate(nil),
+				TicketIDPattern:   `[A-Z][A-Z0-9]+-\d+`,
+				HistoryPickerSize: 100,
 			},
 			Merging: MergingConfig{
 				ManualCommit:       false,
@@ -857,6 +983,8 @@ func GetDefaultConfig() *UserConfig {
 			BranchPrefix:                 "",
 			ParseEmoji:                   false,
 			TruncateCopiedCommitHashesTo: 12,
+			NotesRefs:                    []string(nil),
+			CommitTestCommand:            "",
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
@@ -958,27 +1086,36 @@ func GetDefaultConfig() *UserConfig {
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				RepoHealth:          "i",
+				RepoMaintenance:     "M",
 			},
 			Files: KeybindingFilesConfig{
-				CommitChanges:            "c",
-				CommitChangesWithoutHook: "w",
-				AmendLastCommit:          "A",
-				CommitChangesWithEditor:  "C",
-				FindBaseCommitForFixup:   "<c-f>",
-				IgnoreFile:               "i",
-				RefreshFiles:             "r",
-				StashAllChanges:          "s",
-				ViewStashOptions:         "S",
-				ToggleStagedAll:          "a",
-				ViewResetOptions:         "D",
-				Fetch:                    "f",
-				ToggleTreeView:           "`",
-				OpenMergeOptions:         "M",
-				OpenStatusFilter:         "<c-b>",
-				ConfirmDiscard:           "x",
-				CopyFileInfoToClipboard:  "y",
-				CollapseAll:              "-",
-				ExpandAll:                "=",
+				CommitChanges:             "c",
+				CommitChangesWithoutHook:  "w",
+				AmendLastCommit:           "A",
+				CommitChangesWithEditor:   "C",
+				RunPreCommitHooks:         "V",
+				FindBaseCommitForFixup:    "<c-f>",
+				AbsorbStagedChanges:       "<c-g>",
+				IgnoreFile:                "i",
+				RefreshFiles:              "r",
+				StashAllChanges:           "s",
+				ViewStashOptions:          "S",
+				ToggleStagedAll:           "a",
+				ViewResetOptions:          "D",
+				Fetch:                     "f",
+				ToggleTreeView:            "`",
+				OpenMergeOptions:          "M",
+				OpenStatusFilter:          "<c-b>",
+				ConfirmDiscard:            "x",
+				CopyFileInfoToClipboard:   "y",
+				CollapseAll:               "-",
+				ExpandAll:                 "=",
+				OpenBlame:                 "B",
+				ViewLfsOptions:            "<c-l>",
+				ViewSparseCheckoutOptions: "<c-x>",
+				ApplyMailbox:              "<c-a>",
+				ViewRerereOptions:         "X",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -999,6 +1136,7 @@ func GetDefaultConfig() *UserConfig {
 				FetchRemote:            "f",
 				AddForkRemote:          "F",
 				SortOrder:              "s",
+				ViewStackOptions:       "U",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -1007,6 +1145,7 @@ func GetDefaultConfig() *UserConfig {
 				SquashDown:                     "s",
 				RenameCommit:                   "r",
 				RenameCommitWithEditor:         "R",
+				RegenerateCommitMessage:        "G",
 				ViewResetOptions:               "g",
 				MarkCommitAsFixup:              "f",
 				SetFixupMessage:                "c",
@@ -1030,11 +1169,19 @@ func GetDefaultConfig() *UserConfig {
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
 				SelectCommitsOfCurrentBranch:   "*",
+				ViewNotesOptions:               "<c-n>",
+				FetchLfsObjects:                "<c-f>",
+				ViewRangeDiffOptions:           "D",
+				ExportPatches:                  "E",
+				ViewTestOptions:                "X",
+				ViewRebaseTodoOptions:          "I",
+				ViewStackOptions:               "U",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
-				ResetAuthor: "a",
-				SetAuthor:   "A",
-				AddCoAuthor: "c",
+				ResetAuthor:  "a",
+				SetAuthor:    "A",
+				AddCoAuthor:  "c",
+				EditTrailers: "t",
 			},
 			Stash: KeybindingStashConfig{
 				PopStash:    "g",
@@ -1056,6 +1203,12 @@ func GetDefaultConfig() *UserConfig {
 			CommitMessage: KeybindingCommitMessageConfig{
 				CommitMenu: "<c-o>",
 			},
+			Blame: KeybindingBlameConfig{
+				BlameParentCommit:      "B",
+				ToggleIgnoreWhitespace: "w",
+				ToggleDetectMoves:      "M",
+				ToggleDetectCopies:     "C",
+			},
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func (config *UserConfig) Validate() error {
 	if err := validateEnum("gui.statusPanelView", config.Gui.StatusPanelView,
-		[]string{"dashboard", "allBranchesLog"}); err != nil {
+		[]string{"dashboard", "allBranchesLog", "health"}); err != nil {
 		return err
 	}
 	if err := validateEnum("gui.showDivergenceFromBaseBranch", config.Gui.ShowDivergenceFromBaseBranch,
@@ -45,6 +46,15 @@ func (config *UserConfig) Validate() error {
 	if err := validateCustomCommands(config.CustomCommands); err != nil {
 		return err
 	}
+	if err := validateCommitGenerators(config.Git.Commit.Generator.Commands); err != nil {
+		return err
+	}
+	if err := validateCommitLint(config.Git.Commit.Lint); err != nil {
+		return err
+	}
+	if err := validateCommitTemplates(config.Git.Commit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -161,3 +171,47 @@ func validateCustomCommandPrompt(prompt CustomCommandPrompt) error {
 
 	return nil
 }
+
+func validateCommitGenerators(generators []CommitGeneratorCommand) error {
+	names := make(map[string]bool, len(generators))
+	for i, generator := range generators {
+		if generator.Name == "" {
+			return fmt.Errorf("git.commit.generator.commands[%d] must have a name", i)
+		}
+		if generator.Command == "" {
+			return fmt.Errorf("Commit message generator '%s' must have a command", generator.Name)
+		}
+		if names[generator.Name] {
+			return fmt.Errorf("Duplicate commit message generator name '%s'", generator.Name)
+		}
+		names[generator.Name] = true
+	}
+
+	return nil
+}
+
+func validateCommitLint(lintConfig CommitLintConfig) error {
+	for i, trailer := range lintConfig.RequiredTrailers {
+		if trailer.Key == "" {
+			return fmt.Errorf("git.commit.lint.requiredTrailers[%d] must have a key", i)
+		}
+		if _, err := regexp.Compile(trailer.ValuePattern); err != nil {
+			return fmt.Errorf("Invalid valuePattern for required trailer '%s': %w", trailer.Key, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCommitTemplates(commitConfig CommitConfig) error {
+	for i, template := range commitConfig.Templates {
+		if template.Name == "" {
+			return fmt.Errorf("git.commit.templates[%d] must have a name", i)
+		}
+	}
+	if _, err := regexp.Compile(commitConfig.TicketIDPattern); err != nil {
+		return fmt.Errorf("Invalid git.commit.ticketIdPattern: %w", err)
+	}
+
+	return nil
+}
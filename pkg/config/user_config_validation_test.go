@@ -271,6 +271,90 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "", valid: false},
 			},
 		},
+		{
+			name: "Commit generator name",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.Generator.Commands = []CommitGeneratorCommand{
+					{Name: value, Command: "my-generator"},
+				}
+			},
+			testCases: []testCase{
+				{value: "mine", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Commit generator command",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.Generator.Commands = []CommitGeneratorCommand{
+					{Name: "mine", Command: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "my-generator", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Duplicate commit generator names",
+			setup: func(config *UserConfig, _ string) {
+				config.Git.Commit.Generator.Commands = []CommitGeneratorCommand{
+					{Name: "mine", Command: "my-generator"},
+					{Name: "mine", Command: "my-other-generator"},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Commit lint required trailer key",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.Lint.RequiredTrailers = []CommitLintTrailer{
+					{Key: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "Signed-off-by", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Commit lint required trailer value pattern",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.Lint.RequiredTrailers = []CommitLintTrailer{
+					{Key: "Refs", ValuePattern: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: `^[A-Z]+-\d+$`, valid: true},
+				{value: "[", valid: false},
+			},
+		},
+		{
+			name: "Commit template name",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.Templates = []CommitTemplate{
+					{Name: value, Message: "feat: "},
+				}
+			},
+			testCases: []testCase{
+				{value: "Feature", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Ticket ID pattern",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Commit.TicketIDPattern = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: `#(\d+)`, valid: true},
+				{value: "(", valid: false},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
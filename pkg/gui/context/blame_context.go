@@ -0,0 +1,197 @@
+package context
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type BlameContext struct {
+	*BlameViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+	*SearchTrait
+}
+
+var (
+	_ types.IListContext       = (*BlameContext)(nil)
+	_ types.ISearchableContext = (*BlameContext)(nil)
+)
+
+func NewBlameContext(c *ContextCommon) *BlameContext {
+	viewModel := &BlameViewModel{
+		ListViewModel: NewListViewModel(
+			func() []*models.BlameLine { return c.Model().BlameLines },
+		),
+	}
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetBlameLineListDisplayStrings(
+			c.Model().BlameLines,
+			c.UserConfig().Gui.CommitAuthorLongLength,
+		)
+	}
+
+	ctx := &BlameContext{
+		BlameViewModel:      viewModel,
+		SearchTrait:         NewSearchTrait(c),
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.BlameDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:             c.Views().Blame,
+				WindowName:       "main",
+				Key:              BLAME_CONTEXT_KEY,
+				Kind:             types.MAIN_CONTEXT,
+				Focusable:        true,
+				HighlightOnFocus: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+				getColumnAlignments: func() []utils.Alignment {
+					return []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignLeft}
+				},
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetRenderSearchStatus(ctx.SearchTrait.RenderSearchStatus)
+	ctx.GetView().SetOnSelectItem(ctx.OnSearchSelect)
+
+	return ctx
+}
+
+// What we're blaming: a file as of a given commit, or as in the working tree
+// if the commit is empty
+type BlameTarget struct {
+	Path   string
+	Commit string
+}
+
+type BlameViewModel struct {
+	*ListViewModel[*models.BlameLine]
+
+	target  BlameTarget
+	options git_commands.BlameOptions
+	// The targets we blamed before the current one, so that we can walk
+	// forward again after blaming parent commits
+	history []blameHistoryEntry
+}
+
+type blameHistoryEntry struct {
+	target          BlameTarget
+	selectedLineIdx int
+}
+
+func (self *BlameViewModel) GetTarget() BlameTarget {
+	return self.target
+}
+
+func (self *BlameViewModel) GetOptions() git_commands.BlameOptions {
+	return self.options
+}
+
+func (self *BlameViewModel) SetOptions(options git_commands.BlameOptions) {
+	self.options = options
+}
+
+// Start blaming a new target, forgetting about any previous ones
+func (self *BlameViewModel) Reset(target BlameTarget) {
+	self.target = target
+	self.history = nil
+}
+
+// Start blaming a new target, remembering the current one and its selected
+// line so that we can go back to it
+func (self *BlameViewModel) PushTarget(target BlameTarget) {
+	self.history = append(self.history, blameHistoryEntry{
+		target:          self.target,
+		selectedLineIdx: self.GetSelectedLineIdx(),
+	})
+	self.target = target
+}
+
+// Go back to the target we blamed before the current one, returning the line
+// that was selected in it. Returns false if there is none.
+func (self *BlameViewModel) PopTarget() (int, bool) {
+	if len(self.history) == 0 {
+		return 0, false
+	}
+
+	entry := self.history[len(self.history)-1]
+	self.history = self.history[:len(self.history)-1]
+	self.target = entry.target
+	return entry.selectedLineIdx, true
+}
+
+// Returns the target we'd go back to when popping, if any
+func (self *BlameViewModel) PeekPreviousTarget() (BlameTarget, int, bool) {
+	if len(self.history) == 0 {
+		return BlameTarget{}, 0, false
+	}
+
+	entry := self.history[len(self.history)-1]
+	return entry.target, entry.selectedLineIdx, true
+}
+
+func (self *BlameContext) UpdateTitle() {
+	self.SetTitleRef(self.titleRef())
+	self.GetView().Title = self.Title()
+}
+
+// The flags that are passed to git blame, e.g. "-w -M", for showing in the title
+func (self *BlameViewModel) OptionFlags() string {
+	flags := []string{}
+	if self.options.IgnoreWhitespace {
+		flags = append(flags, "-w")
+	}
+	if self.options.DetectMoves {
+		flags = append(flags, "-M")
+	}
+	if self.options.DetectCopies {
+		flags = append(flags, "-C")
+	}
+	return strings.Join(flags, " ")
+}
+
+func (self *BlameContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+	columnPositions := self.ColumnPositions()
+	if columnPositions == nil {
+		// We haven't rendered anything yet, see searchModelCommits
+		return []gocui.SearchPosition{}
+	}
+
+	normalize := lo.Ternary(caseSensitive, func(s string) string { return s }, strings.ToLower)
+	return lo.FilterMap(self.GetItems(), func(line *models.BlameLine, idx int) (gocui.SearchPosition, bool) {
+		// As for commits, the XStart and XEnd values are only used when
+		// searching for a hash that is longer than the one we render, so they
+		// span the hash column, which is the first one.
+		result := gocui.SearchPosition{XStart: columnPositions[0], XEnd: columnPositions[1] - 1, Y: self.ModelIndexToViewIndex(idx)}
+		return result, strings.Contains(normalize(line.Hash), searchStr) ||
+			strings.Contains(normalize(line.Author), searchStr) ||
+			strings.Contains(normalize(line.Content), searchStr)
+	})
+}
+
+func (self *BlameContext) titleRef() string {
+	ref := self.target.Path
+	if commit := self.target.Commit; commit != "" {
+		// Shorten hashes, but not ref names like HEAD or refs/stash@{0}
+		if strings.Trim(commit, "0123456789abcdef") == "" {
+			commit = utils.ShortHash(commit)
+		}
+		ref = fmt.Sprintf("%s @ %s", ref, commit)
+	}
+	if flags := self.OptionFlags(); flags != "" {
+		ref = fmt.Sprintf("%s (%s)", ref, flags)
+	}
+	return ref
+}
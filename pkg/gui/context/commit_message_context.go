@@ -41,6 +41,11 @@ type CommitMessageViewModel struct {
 	onConfirm func(string, string) error
 	// invoked when pressing the switch-to-editor key binding
 	onSwitchToEditor func(string) error
+	// invoked when choosing to generate a message from the commit menu
+	onGenerate func() error
+	// returns the lint rule violations to show in the subtitle; nil if the
+	// message isn't linted
+	lintMessage func(string, string) string
 
 	// the following two fields are used for the display of the "hooks disabled" subtitle
 	forceSkipHooks  bool
@@ -152,6 +157,8 @@ func (self *CommitMessageContext) SetPanelState(
 	initialMessage string,
 	onConfirm func(string, string) error,
 	onSwitchToEditor func(string) error,
+	onGenerate func() error,
+	lintMessage func(string, string) string,
 	forceSkipHooks bool,
 	skipHooksPrefix string,
 ) {
@@ -160,6 +167,8 @@ func (self *CommitMessageContext) SetPanelState(
 	self.viewModel.initialMessage = initialMessage
 	self.viewModel.onConfirm = onConfirm
 	self.viewModel.onSwitchToEditor = onSwitchToEditor
+	self.viewModel.onGenerate = onGenerate
+	self.viewModel.lintMessage = lintMessage
 	self.viewModel.forceSkipHooks = forceSkipHooks
 	self.viewModel.skipHooksPrefix = skipHooksPrefix
 	self.GetView().Title = summaryTitle
@@ -181,6 +190,15 @@ func (self *CommitMessageContext) RenderSubtitle() {
 	if self.viewModel.forceSkipHooks || (skipHookPrefix != "" && strings.HasPrefix(subject, skipHookPrefix)) {
 		subtitle = self.c.Tr.CommitHooksDisabledSubTitle
 	}
+	if self.viewModel.lintMessage != nil {
+		description := strings.TrimSpace(self.c.Views().CommitDescription.TextArea.GetUnwrappedContent())
+		if violations := self.viewModel.lintMessage(strings.TrimSpace(subject), description); violations != "" {
+			if subtitle != "" {
+				subtitle += "─"
+			}
+			subtitle += " " + violations + " "
+		}
+	}
 	if self.c.UserConfig().Gui.CommitLength.Show {
 		if subtitle != "" {
 			subtitle += "─"
@@ -201,3 +219,11 @@ func (self *CommitMessageContext) SwitchToEditor(message string) error {
 func (self *CommitMessageContext) CanSwitchToEditor() bool {
 	return self.viewModel.onSwitchToEditor != nil
 }
+
+func (self *CommitMessageContext) Generate() error {
+	return self.viewModel.onGenerate()
+}
+
+func (self *CommitMessageContext) CanGenerate() bool {
+	return self.viewModel.onGenerate != nil
+}
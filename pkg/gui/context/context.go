@@ -25,6 +25,8 @@ const (
 	LOCAL_COMMITS_CONTEXT_KEY            types.ContextKey = "commits"
 	REFLOG_COMMITS_CONTEXT_KEY           types.ContextKey = "reflogCommits"
 	SUB_COMMITS_CONTEXT_KEY              types.ContextKey = "subCommits"
+	RANGE_DIFF_CONTEXT_KEY               types.ContextKey = "rangeDiff"
+	RERERE_RESOLUTIONS_CONTEXT_KEY       types.ContextKey = "rerereResolutions"
 	COMMIT_FILES_CONTEXT_KEY             types.ContextKey = "commitFiles"
 	STASH_CONTEXT_KEY                    types.ContextKey = "stash"
 	NORMAL_MAIN_CONTEXT_KEY              types.ContextKey = "normal"
@@ -34,6 +36,7 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
@@ -72,6 +75,8 @@ var AllContextKeys = []types.ContextKey{
 	LOCAL_COMMITS_CONTEXT_KEY,
 	REFLOG_COMMITS_CONTEXT_KEY,
 	SUB_COMMITS_CONTEXT_KEY,
+	RANGE_DIFF_CONTEXT_KEY,
+	RERERE_RESOLUTIONS_CONTEXT_KEY,
 	COMMIT_FILES_CONTEXT_KEY,
 	STASH_CONTEXT_KEY,
 	NORMAL_MAIN_CONTEXT_KEY,
@@ -81,6 +86,7 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -113,6 +119,8 @@ type ContextTree struct {
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
 	SubCommits                  *SubCommitsContext
+	RangeDiff                   *RangeDiffContext
+	RerereResolutions           *RerereResolutionsContext
 	Stash                       *StashContext
 	Suggestions                 *SuggestionsContext
 	Normal                      *MainContext
@@ -122,6 +130,7 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	Blame                       *BlameContext
 	Confirmation                *ConfirmationContext
 	Prompt                      *PromptContext
 	CommitMessage               *CommitMessageContext
@@ -154,6 +163,8 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.StagedFiles,
 		self.Files,
 		self.SubCommits,
+		self.RangeDiff,
+		self.RerereResolutions,
 		self.Remotes,
 		self.RemoteBranches,
 		self.Tags,
@@ -169,6 +180,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.CommitDescription,
 
 		self.MergeConflicts,
+		self.Blame,
 		self.StagingSecondary,
 		self.Staging,
 		self.CustomPatchBuilderSecondary,
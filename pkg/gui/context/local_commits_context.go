@@ -62,6 +62,7 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			endIdx,
 			shouldShowGraph(c),
 			c.Model().BisectInfo,
+			c.Model().BranchStack,
 		)
 	}
 
@@ -0,0 +1,65 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffContext struct {
+	*RangeDiffViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+}
+
+var _ types.IListContext = (*RangeDiffContext)(nil)
+
+func NewRangeDiffContext(c *ContextCommon) *RangeDiffContext {
+	viewModel := &RangeDiffViewModel{
+		ListViewModel: NewListViewModel(
+			func() []*models.RangeDiffPair { return c.Model().RangeDiffPairs },
+		),
+	}
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetRangeDiffPairListDisplayStrings(c.Model().RangeDiffPairs)
+	}
+
+	return &RangeDiffContext{
+		RangeDiffViewModel:  viewModel,
+		DynamicTitleBuilder: NewDynamicTitleBuilder(c.Tr.RangeDiffDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:                        c.Views().RangeDiff,
+				WindowName:                  "commits",
+				Key:                         RANGE_DIFF_CONTEXT_KEY,
+				Kind:                        types.SIDE_CONTEXT,
+				Focusable:                   true,
+				Transient:                   true,
+				NeedsRerenderOnHeightChange: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
+
+type RangeDiffViewModel struct {
+	*ListViewModel[*models.RangeDiffPair]
+
+	// The two ranges being compared, e.g. "main..HEAD@{1}" and "main..HEAD"
+	oldRange string
+	newRange string
+}
+
+func (self *RangeDiffViewModel) SetRanges(oldRange string, newRange string) {
+	self.oldRange = oldRange
+	self.newRange = newRange
+}
+
+func (self *RangeDiffViewModel) GetRanges() (string, string) {
+	return self.oldRange, self.newRange
+}
@@ -0,0 +1,44 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RerereResolutionsContext struct {
+	*ListViewModel[*models.RerereResolution]
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*RerereResolutionsContext)(nil)
+
+func NewRerereResolutionsContext(c *ContextCommon) *RerereResolutionsContext {
+	viewModel := NewListViewModel(
+		func() []*models.RerereResolution { return c.Model().RerereResolutions },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetRerereResolutionListDisplayStrings(c.Model().RerereResolutions, c.Tr)
+	}
+
+	return &RerereResolutionsContext{
+		ListViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:                        c.Views().RerereResolutions,
+				WindowName:                  "files",
+				Key:                         RERERE_RESOLUTIONS_CONTEXT_KEY,
+				Kind:                        types.SIDE_CONTEXT,
+				Focusable:                   true,
+				Transient:                   true,
+				NeedsRerenderOnHeightChange: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
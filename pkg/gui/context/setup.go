@@ -61,23 +61,25 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
-		StagedFiles:     NewStagedFilesContext(c),
-		Files:           NewWorkingTreeContext(c),
-		Submodules:      NewSubmodulesContext(c),
-		Menu:            NewMenuContext(c),
-		Remotes:         NewRemotesContext(c),
-		Worktrees:       NewWorktreesContext(c),
-		RemoteBranches:  NewRemoteBranchesContext(c),
-		LocalCommits:    NewLocalCommitsContext(c),
-		CommitFiles:     commitFilesContext,
-		ReflogCommits:   NewReflogCommitsContext(c),
-		SubCommits:      NewSubCommitsContext(c),
-		Branches:        NewBranchesContext(c),
-		Tags:            NewTagsContext(c),
-		Stash:           NewStashContext(c),
-		Suggestions:     NewSuggestionsContext(c),
-		Normal:          NewMainContext(c.Views().Main, "main", NORMAL_MAIN_CONTEXT_KEY, c),
-		NormalSecondary: NewMainContext(c.Views().Secondary, "secondary", NORMAL_SECONDARY_CONTEXT_KEY, c),
+		StagedFiles:       NewStagedFilesContext(c),
+		Files:             NewWorkingTreeContext(c),
+		Submodules:        NewSubmodulesContext(c),
+		Menu:              NewMenuContext(c),
+		Remotes:           NewRemotesContext(c),
+		Worktrees:         NewWorktreesContext(c),
+		RemoteBranches:    NewRemoteBranchesContext(c),
+		LocalCommits:      NewLocalCommitsContext(c),
+		CommitFiles:       commitFilesContext,
+		ReflogCommits:     NewReflogCommitsContext(c),
+		SubCommits:        NewSubCommitsContext(c),
+		RangeDiff:         NewRangeDiffContext(c),
+		RerereResolutions: NewRerereResolutionsContext(c),
+		Branches:          NewBranchesContext(c),
+		Tags:              NewTagsContext(c),
+		Stash:             NewStashContext(c),
+		Suggestions:       NewSuggestionsContext(c),
+		Normal:            NewMainContext(c.Views().Main, "main", NORMAL_MAIN_CONTEXT_KEY, c),
+		NormalSecondary:   NewMainContext(c.Views().Secondary, "secondary", NORMAL_SECONDARY_CONTEXT_KEY, c),
 		Staging: NewPatchExplorerContext(
 			c.Views().Staging,
 			"main",
@@ -120,6 +122,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		MergeConflicts: NewMergeConflictsContext(
 			c,
 		),
+		Blame:         NewBlameContext(c),
 		Confirmation:  NewConfirmationContext(c),
 		Prompt:        NewPromptContext(c),
 		CommitMessage: NewCommitMessageContext(c),
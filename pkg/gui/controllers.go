@@ -44,7 +44,9 @@ func (gui *Gui) resetHelpersAndControllers() {
 	getUnwrappedCommitDescription := func() string {
 		return strings.TrimSpace(gui.Views.CommitDescription.TextArea.GetUnwrappedContent())
 	}
+	trailersHelper := helpers.NewTrailersHelper(helperCommon, suggestionsHelper)
 	commitsHelper := helpers.NewCommitsHelper(helperCommon,
+		trailersHelper,
 		getCommitSummary,
 		setCommitSummary,
 		getCommitDescription,
@@ -52,7 +54,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		setCommitDescription,
 	)
 
+	commitGeneratorHelper := helpers.NewCommitGeneratorHelper(helperCommon, commitsHelper)
+	commitLintHelper := helpers.NewCommitLintHelper(helperCommon)
 	gpgHelper := helpers.NewGpgHelper(helperCommon)
+	workingTreeHelper := helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, rebaseHelper, commitGeneratorHelper, commitLintHelper)
 	viewHelper := helpers.NewViewHelper(helperCommon, gui.State.Contexts)
 	patchBuildingHelper := helpers.NewPatchBuildingHelper(helperCommon)
 	stagingHelper := helpers.NewStagingHelper(helperCommon)
@@ -98,7 +103,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Bisect:          bisectHelper,
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
-		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, rebaseHelper),
+		WorkingTree:     workingTreeHelper,
 		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
 		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
@@ -107,8 +112,21 @@ func (gui *Gui) resetHelpersAndControllers() {
 		CherryPick:      cherryPickHelper,
 		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
-		FixupHelper:     helpers.NewFixupHelper(helperCommon),
+		FixupHelper:     helpers.NewFixupHelper(helperCommon, rebaseHelper),
 		Commits:         commitsHelper,
+		CommitGenerator: commitGeneratorHelper,
+		CommitLint:      commitLintHelper,
+		Trailers:        trailersHelper,
+		CommitTemplates: helpers.NewCommitTemplatesHelper(helperCommon),
+		PreCommitHooks:  helpers.NewPreCommitHooksHelper(helperCommon, workingTreeHelper),
+		Blame:           helpers.NewBlameHelper(helperCommon),
+		Lfs:             helpers.NewLfsHelper(helperCommon, suggestionsHelper),
+		SparseCheckout:  helpers.NewSparseCheckoutHelper(helperCommon),
+		RangeDiff:       helpers.NewRangeDiffHelper(helperCommon),
+		BranchStack:     helpers.NewBranchStackHelper(helperCommon, refsHelper, rebaseHelper),
+		PatchSeries:     helpers.NewPatchSeriesHelper(helperCommon, suggestionsHelper, rebaseHelper),
+		Rerere:          helpers.NewRerereHelper(helperCommon),
+		RepoHealth:      helpers.NewRepoHealthHelper(helperCommon),
 		SuspendResume:   helpers.NewSuspendResumeHelper(helperCommon),
 		Snake:           helpers.NewSnakeHelper(helperCommon),
 		Diff:            diffHelper,
@@ -147,6 +165,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	submodulesController := controllers.NewSubmodulesController(common)
 
 	bisectController := controllers.NewBisectController(common)
+	commitTestingController := controllers.NewCommitTestingController(common)
+	rebaseTodosController := controllers.NewRebaseTodosController(common)
 
 	commitMessageController := controllers.NewCommitMessageController(
 		common,
@@ -168,8 +188,9 @@ func (gui *Gui) resetHelpersAndControllers() {
 	commitInputController := controllers.NewCommitInputController(common)
 	commitButtonController := controllers.NewCommitButtonController(common)
 	commitGenerateButtonController := controllers.NewCommitGenerateButtonController(common)
-	commitPushButtonController := controllers.NewCommitPushButtonController(common, syncController.HandlePush)
+	commitPushButtonController := controllers.NewCommitPushButtonController(common, syncController.ResolveCustomPushCommand)
 	mergeConflictsController := controllers.NewMergeConflictsController(common)
+	blameController := controllers.NewBlameController(common)
 	remotesController := controllers.NewRemotesController(
 		common,
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
@@ -195,6 +216,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	snakeController := controllers.NewSnakeController(common)
 	reflogCommitsController := controllers.NewReflogCommitsController(common)
 	subCommitsController := controllers.NewSubCommitsController(common)
+	rangeDiffController := controllers.NewRangeDiffController(common)
+	rerereResolutionsController := controllers.NewRerereResolutionsController(common)
 	statusController := controllers.NewStatusController(common)
 	commandLogController := controllers.NewCommandLogController(common)
 	confirmationController := controllers.NewConfirmationController(common)
@@ -309,6 +332,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		subCommitsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.RangeDiff,
+		rangeDiffController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.RerereResolutions,
+		rerereResolutionsController,
+	)
+
 	// TODO: add scroll controllers for main panels (need to bring some more functionality across for that e.g. reading more from the currently displayed git command)
 	controllers.AttachControllers(gui.State.Contexts.Staging,
 		stagingController,
@@ -336,6 +367,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		blameController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Normal,
 		mainViewController,
 		verticalScrollControllerFactory.Create(gui.State.Contexts.Normal),
@@ -388,6 +423,8 @@ func (gui *Gui) resetHelpersAndControllers() {
 	controllers.AttachControllers(gui.State.Contexts.LocalCommits,
 		localCommitsController,
 		bisectController,
+		commitTestingController,
+		rebaseTodosController,
 	)
 
 	controllers.AttachControllers(gui.State.Contexts.CommitFiles,
@@ -1,12 +1,19 @@
 package controllers
 
 import (
+	"bufio"
 	"fmt"
+	"os/exec"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -16,6 +23,26 @@ type BisectController struct {
 	baseController
 	*ListControllerTrait[*models.Commit]
 	c *ControllerCommon
+
+	// The 'git bisect run' that is in progress, if any
+	running atomic.Pointer[bisectRun]
+}
+
+type bisectRun struct {
+	command    string
+	cancelled  atomic.Bool
+	runningCmd atomic.Pointer[exec.Cmd]
+
+	// Only accessed on the UI thread
+	output        []string
+	showingOutput bool
+}
+
+func (self *bisectRun) cancel() {
+	self.cancelled.Store(true)
+	if cmd := self.runningCmd.Load(); cmd != nil {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
 }
 
 var _ types.IController = &BisectController{}
@@ -51,6 +78,10 @@ func (self *BisectController) GetKeybindings(opts types.KeybindingsOpts) []*type
 func (self *BisectController) openMenu(commit *models.Commit) error {
 	// no shame in getting this directly rather than using the cached value
 	// given how cheap it is to obtain
+	if run := self.running.Load(); run != nil {
+		return self.openRunningMenu(run)
+	}
+
 	info := self.c.Git().Bisect.GetInfo()
 	if info.Started() {
 		return self.openMidBisectMenu(info, commit)
@@ -145,6 +176,17 @@ func (self *BisectController) openMidBisectMenu(info *git_commands.BisectInfo, c
 			Key:            'S',
 		}))
 	}
+	var notBisecting *types.DisabledReason
+	if !bisecting {
+		notBisecting = &types.DisabledReason{Text: self.c.Tr.Bisect.RunRequiresBisecting}
+	}
+	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
+		Label:          self.c.Tr.Bisect.RunCommand,
+		Tooltip:        self.c.Tr.Bisect.RunCommandTooltip,
+		OnPress:        self.promptForRunCommand,
+		DisabledReason: notBisecting,
+		Key:            'x',
+	}))
 	menuItems = append(menuItems, lo.ToPtr(types.MenuItem{
 		Label: self.c.Tr.Bisect.ResetOption,
 		OnPress: func() error {
@@ -256,6 +298,219 @@ func (self *BisectController) showBisectCompleteMessage(candidateHashes []string
 	return nil
 }
 
+func (self *BisectController) promptForRunCommand() error {
+	shellCommandAction := &ShellCommandAction{c: self.c}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.Bisect.RunPrompt,
+		FindSuggestionsFunc: shellCommandAction.GetShellCommandsHistorySuggestionsFunc(),
+		AllowEditSuggestion: true,
+		PreserveWhitespace:  true,
+		HandleConfirm: func(command string) error {
+			shellCommandAction.addToHistory(command)
+
+			return self.run(command)
+		},
+		HandleDeleteSuggestion: shellCommandAction.deleteFromHistory,
+	})
+
+	return nil
+}
+
+// While 'git bisect run' is running, the bisect menu only offers to show its
+// output or cancel it
+func (self *BisectController) openRunningMenu(run *bisectRun) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf(self.c.Tr.Bisect.RunTitle, run.command),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.Bisect.ShowRunOutput,
+				OnPress: func() error {
+					self.showRunOutput(run)
+					return nil
+				},
+				Key: 'o',
+			},
+			{
+				Label:   self.c.Tr.Bisect.CancelRun,
+				OnPress: func() error { return self.cancelRun(run) },
+				Key:     'c',
+			},
+		},
+	})
+}
+
+// Runs 'git bisect run' in the background, showing its output in a popup as it
+// comes in. Closing the popup doesn't stop the run; cancelling kills git
+// together with the test command, which leaves the bisect where it was, so the
+// user can carry on bisecting manually or run another command.
+func (self *BisectController) run(command string) error {
+	self.c.LogAction(self.c.Tr.Actions.BisectRun)
+
+	run := &bisectRun{command: command}
+	self.running.Store(run)
+	self.showRunOutput(run)
+
+	return self.c.WithWaitingStatus(self.c.Tr.Bisect.RunningStatus, func(gocui.Task) error {
+		err := self.runBisectCommand(run, func(line string) {
+			self.c.OnUIThread(func() error {
+				run.output = append(run.output, line)
+				self.c.LogCommand(line, false)
+				self.updateRunOutput(run)
+
+				// git has just recorded the verdict for the commit it tested,
+				// and checked out the next one
+				if strings.HasPrefix(line, "Bisecting:") {
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+				}
+				return nil
+			})
+		})
+
+		self.c.OnUIThread(func() error {
+			self.running.Store(nil)
+			self.closeRunOutput(run)
+			if run.cancelled.Load() {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return self.afterRun(run.output)
+		})
+
+		return nil
+	})
+}
+
+func (self *BisectController) runBisectCommand(run *bisectRun, onLine func(line string)) error {
+	cmdObj := self.c.Git().Bisect.RunCmdObj(run.command)
+	cmd := cmdObj.GetCmd()
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	// The test command is a child of git, so we need to kill the whole process
+	// group to stop it
+	oscommands.StartInNewProcessGroup(cmd)
+	// Don't wait for the output of processes that survive being killed
+	cmd.WaitDelay = time.Second
+
+	self.c.LogCommand(cmdObj.ToString(), true)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	run.runningCmd.Store(cmd)
+	defer run.runningCmd.Store(nil)
+	// The run may have been cancelled before we stored the command
+	if run.cancelled.Load() {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
+
+	scanner := bufio.NewScanner(stdoutPipe)
+	scanner.Split(utils.ScanLinesAndTruncateWhenLongerThanBuffer(bufio.MaxScanTokenSize))
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+
+	// git exits with an error if it couldn't find the first bad commit; we
+	// tell the user about that in afterRun, together with the output
+	_ = cmd.Wait()
+	return scanner.Err()
+}
+
+func (self *BisectController) showRunOutput(run *bisectRun) {
+	run.showingOutput = true
+	onClose := func() error {
+		run.showingOutput = false
+		return nil
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:         fmt.Sprintf(self.c.Tr.Bisect.RunTitle, run.command),
+		Prompt:        self.runOutputText(run),
+		HandleConfirm: onClose,
+		HandleClose:   onClose,
+	})
+}
+
+func (self *BisectController) updateRunOutput(run *bisectRun) {
+	if !run.showingOutput {
+		return
+	}
+
+	view := self.c.Views().Confirmation
+	self.c.SetViewContent(view, style.AttrBold.Sprint(self.runOutputText(run)))
+	self.c.Helpers().Confirmation.ResizeCurrentPopupPanels()
+	// Follow the output as it comes in
+	view.SetOriginY(max(0, view.ViewLinesHeight()-view.InnerHeight()))
+}
+
+func (self *BisectController) closeRunOutput(run *bisectRun) {
+	if !run.showingOutput {
+		return
+	}
+
+	run.showingOutput = false
+	if self.c.Context().Current() == self.c.Contexts().Confirmation {
+		self.c.Context().Pop()
+	}
+}
+
+func (self *BisectController) runOutputText(run *bisectRun) string {
+	if len(run.output) == 0 {
+		return self.c.Tr.Bisect.RunWaitingForOutput
+	}
+
+	return strings.Join(run.output, "\n")
+}
+
+func (self *BisectController) cancelRun(run *bisectRun) error {
+	if self.running.Load() != run {
+		return nil
+	}
+
+	run.cancel()
+
+	self.c.Toast(self.c.Tr.Bisect.RunCancelled)
+	self.c.Helpers().Bisect.PostBisectCommandRefresh()
+	return nil
+}
+
+func (self *BisectController) afterRun(output []string) error {
+	done, candidateHashes, err := self.c.Git().Bisect.IsDone()
+	if err != nil {
+		return err
+	}
+
+	if !done {
+		self.c.Helpers().Bisect.PostBisectCommandRefresh()
+		self.c.Alert(self.c.Tr.Bisect.RunFailedTitle, fmt.Sprintf(self.c.Tr.Bisect.RunFailed, strings.Join(output, "\n")))
+		return nil
+	}
+
+	if len(candidateHashes) > 1 {
+		self.c.Helpers().Bisect.PostBisectCommandRefresh()
+		return self.showBisectCompleteMessage(candidateHashes)
+	}
+
+	self.c.Refresh(types.RefreshOptions{
+		Mode:  types.SYNC,
+		Scope: []types.RefreshableView{},
+		Then: func() {
+			self.selectCommit(candidateHashes[0])
+			if self.c.Context().Current() != self.context() {
+				self.c.Context().Push(self.context(), types.OnFocusOpts{})
+			}
+		},
+	})
+
+	self.c.Toast(fmt.Sprintf(self.c.Tr.Bisect.RunFoundCommit, utils.ShortHash(candidateHashes[0])))
+	return nil
+}
+
 func (self *BisectController) afterMark(selectCurrent bool, waitToReselect bool) error {
 	done, candidateHashes, err := self.c.Git().Bisect.IsDone()
 	if err != nil {
@@ -294,13 +549,17 @@ func (self *BisectController) afterBisectMarkRefresh(selectCurrent bool, waitToR
 func (self *BisectController) selectCurrentBisectCommit() {
 	info := self.c.Git().Bisect.GetInfo()
 	if info.GetCurrentHash() != "" {
-		// find index of commit with that hash, move cursor to that.
-		for i, commit := range self.c.Model().Commits {
-			if commit.Hash() == info.GetCurrentHash() {
-				self.context().SetSelection(i)
-				self.context().HandleFocus(types.OnFocusOpts{})
-				break
-			}
+		self.selectCommit(info.GetCurrentHash())
+	}
+}
+
+func (self *BisectController) selectCommit(hash string) {
+	// find index of commit with that hash, move cursor to that.
+	for i, commit := range self.c.Model().Commits {
+		if commit.Hash() == hash {
+			self.context().SetSelection(i)
+			self.context().HandleFocus(types.OnFocusOpts{})
+			break
 		}
 	}
 }
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type BlameController struct {
+	baseController
+	*ListControllerTrait[*models.BlameLine]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	c *ControllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().Blame,
+			c.Contexts().Blame.GetSelected,
+			c.Contexts().Blame.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.goToCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineIsCommitted)),
+			Description:       self.c.Tr.BlameGoToCommit,
+			Tooltip:           self.c.Tr.BlameGoToCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Blame.BlameParentCommit),
+			Handler:           self.withItem(self.blameParentCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineHasParent)),
+			Description:       self.c.Tr.BlameParentCommit,
+			Tooltip:           self.c.Tr.BlameParentCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key: opts.GetKey(opts.Config.Blame.ToggleIgnoreWhitespace),
+			Handler: self.toggleOption(func(options *git_commands.BlameOptions) {
+				options.IgnoreWhitespace = !options.IgnoreWhitespace
+			}),
+			Description: self.c.Tr.BlameToggleIgnoreWhitespace,
+			Tooltip:     self.c.Tr.BlameToggleIgnoreWhitespaceTooltip,
+		},
+		{
+			Key: opts.GetKey(opts.Config.Blame.ToggleDetectMoves),
+			Handler: self.toggleOption(func(options *git_commands.BlameOptions) {
+				options.DetectMoves = !options.DetectMoves
+			}),
+			Description: self.c.Tr.BlameToggleDetectMoves,
+			Tooltip:     self.c.Tr.BlameToggleDetectMovesTooltip,
+		},
+		{
+			Key: opts.GetKey(opts.Config.Blame.ToggleDetectCopies),
+			Handler: self.toggleOption(func(options *git_commands.BlameOptions) {
+				options.DetectCopies = !options.DetectCopies
+			}),
+			Description: self.c.Tr.BlameToggleDetectCopies,
+			Tooltip:     self.c.Tr.BlameToggleDetectCopiesTooltip,
+		},
+		{
+			Key:             opts.GetKey(opts.Config.Universal.Return),
+			Handler:         self.c.Helpers().Blame.GoBack,
+			Description:     self.c.Tr.ExitBlame,
+			Tooltip:         self.c.Tr.ExitBlameTooltip,
+			DisplayOnScreen: true,
+		},
+	}
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
+
+func (self *BlameController) goToCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.GoToCommit(line.Hash)
+}
+
+func (self *BlameController) blameParentCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.BlameParentCommit(line)
+}
+
+func (self *BlameController) toggleOption(toggle func(options *git_commands.BlameOptions)) func() error {
+	return func() error {
+		options := self.context().GetOptions()
+		toggle(&options)
+		return self.c.Helpers().Blame.SetOptions(options)
+	}
+}
+
+func (self *BlameController) lineIsCommitted(line *models.BlameLine) *types.DisabledReason {
+	if line.IsUncommitted() {
+		return &types.DisabledReason{Text: self.c.Tr.BlameLineNotCommitted}
+	}
+
+	return nil
+}
+
+func (self *BlameController) lineHasParent(line *models.BlameLine) *types.DisabledReason {
+	if line.PreviousHash == "" {
+		return &types.DisabledReason{Text: self.c.Tr.BlameLineHasNoParent}
+	}
+
+	return nil
+}
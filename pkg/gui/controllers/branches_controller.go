@@ -141,6 +141,14 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.NewTag,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewStackOptions),
+			Handler:           self.openBranchStackMenu,
+			GetDisabledReason: self.c.Helpers().BranchStack.GetDisabledReason,
+			Description:       self.c.Tr.ViewStackOptions,
+			Tooltip:           self.c.Tr.ViewStackOptionsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.SortOrder),
 			Handler:     self.createSortMenu,
@@ -696,6 +704,10 @@ func (self *BranchesController) createSortMenu() error {
 		self.c.UserConfig().Git.LocalBranchSortOrder)
 }
 
+func (self *BranchesController) openBranchStackMenu() error {
+	return self.c.Helpers().BranchStack.OpenMenu(nil)
+}
+
 func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name, selectedBranch.FullRefName())
 }
@@ -124,9 +124,7 @@ func (self *CommitButtonController) onClick(gocui.ViewMouseBindingOpts) error {
 
 	message := strings.TrimSpace(self.c.Views().CommitInput.TextArea.GetUnwrappedContent())
 	return self.c.Helpers().WorkingTree.CommitStagedWithMessage(message, false, func() error {
-		view := self.c.Views().CommitInput
-		view.ClearTextArea()
-		view.RenderTextArea()
+		self.c.Helpers().WorkingTree.ClearCommitInput()
 		return nil
 	})
 }
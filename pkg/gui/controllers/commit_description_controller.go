@@ -123,6 +123,13 @@ func (self *CommitDescriptionController) handleTogglePanel() error {
 }
 
 func (self *CommitDescriptionController) close() error {
+	// The first press only cancels a running generation, so that the user
+	// can still edit what they had typed
+	if self.c.Helpers().CommitGenerator.IsGenerating() {
+		self.c.Helpers().CommitGenerator.CancelGeneration()
+		return nil
+	}
+
 	self.c.Helpers().Commits.CloseCommitMessagePanel()
 	return nil
 }
@@ -1,14 +1,12 @@
 package controllers
 
 import (
-	"bytes"
-	"fmt"
-	"os"
-	"os/exec"
-	"path/filepath"
+	"errors"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -20,7 +18,6 @@ type CommitGenerateButtonController struct {
 const (
 	commitGenerateButtonDefaultLabel = "[ Generate ]"
 	commitGenerateButtonFocusedLabel = "[*Generate*]"
-	commitMessageOutputMaxChars      = 600
 )
 
 var _ types.IController = &CommitGenerateButtonController{}
@@ -123,112 +120,64 @@ func (self *CommitGenerateButtonController) focusStagedFiles() error {
 }
 
 func (self *CommitGenerateButtonController) onClick(gocui.ViewMouseBindingOpts) error {
+	if self.c.Helpers().CommitGenerator.IsGenerating() {
+		return errors.New(self.c.Tr.CommitGenerationInProgress)
+	}
+
+	return self.c.Helpers().CommitGenerator.WithGenerator(self.generate)
+}
+
+func (self *CommitGenerateButtonController) generate(generator config.CommitGeneratorCommand) error {
 	// Focus the input immediately so the user sees where the message will land.
 	self.c.Context().Push(self.c.Contexts().CommitInput, types.OnFocusOpts{})
 
-	self.c.WithWaitingStatus("AI: generating commit message", func(gocui.Task) error {
-		message, err := self.generateCommitMessage()
-		if err != nil {
+	// Remember what was there before so that we can restore it if generation
+	// fails or is cancelled halfway through streaming.
+	originalMessage := self.c.Views().CommitInput.TextArea.GetContent()
+
+	generatorHelper := self.c.Helpers().CommitGenerator
+	statusText := generatorHelper.GeneratingStatus(self.c.Tr.GeneratingCommitMessageStatus)
+	return generatorHelper.RunGeneration(statusText, func(task *helpers.CommitGenerationTask) error {
+		message, err := generatorHelper.GenerateFromStagedChanges(task, generator, func(partialMessage string) {
+			// Don't show a JSON response while it's coming in; we'll show the
+			// parsed message once it's complete.
+			if strings.HasPrefix(partialMessage, "{") {
+				return
+			}
 			self.c.OnUIThread(func() error {
-				self.c.ErrorToast(fmt.Sprintf("AI: %v", err))
+				self.c.Helpers().WorkingTree.SetCommitInputContent(partialMessage)
 				return nil
 			})
-			return nil
-		}
-
-		message = sanitizeCommitMessage(message)
-		if message == "" {
+		})
+		if err != nil {
 			self.c.OnUIThread(func() error {
-				self.c.ErrorToast("AI: empty commit message")
+				self.c.Helpers().WorkingTree.SetCommitInputContent(originalMessage)
+				if errors.Is(err, helpers.ErrCommitGenerationCancelled) {
+					self.c.Toast(self.c.Tr.CommitGenerationCancelled)
+				} else {
+					self.c.ErrorToast(err.Error())
+				}
 				return nil
 			})
 			return nil
 		}
 
 		self.c.OnUIThread(func() error {
-			view := self.c.Views().CommitInput
-			view.ClearTextArea()
-			view.TextArea.TypeString(message)
-			view.RenderTextArea()
-
+			self.c.Helpers().WorkingTree.SetCommitInputContent(self.commitInputContent(message))
 			self.c.Context().Push(self.c.Contexts().CommitInput, types.OnFocusOpts{})
 			return nil
 		})
 
 		return nil
 	})
-
-	return nil
-}
-
-func (self *CommitGenerateButtonController) generateCommitMessage() (string, error) {
-	repoPath := self.c.Git().RepoPaths.RepoPath()
-	zeemuxPath, err := resolveBundledZeeMuxPath()
-	if err != nil {
-		return "", err
-	}
-
-	cmd := exec.Command(zeemuxPath, "llm", "commit-msg", "--provider", "qwen", "--repo", repoPath)
-	cmd.Dir = repoPath
-
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		msg := strings.TrimSpace(stderr.String())
-		if msg != "" {
-			return "", fmt.Errorf("zeemux llm commit-msg failed: %s", msg)
-		}
-		return "", err
-	}
-
-	return strings.TrimSpace(stdout.String()), nil
-}
-
-func sanitizeCommitMessage(message string) string {
-	clean := strings.ReplaceAll(message, "\r\n", "\n")
-	clean = strings.ReplaceAll(clean, "\r", "\n")
-	clean = strings.TrimSpace(clean)
-	if clean == "" {
-		return ""
-	}
-
-	if len(clean) <= commitMessageOutputMaxChars {
-		return clean
-	}
-
-	truncatedSuffix := "\n... (truncated)"
-	if commitMessageOutputMaxChars <= len(truncatedSuffix) {
-		return clean[:commitMessageOutputMaxChars]
-	}
-	base := strings.TrimRight(clean[:commitMessageOutputMaxChars-len(truncatedSuffix)], " \t\n")
-	return base + truncatedSuffix
 }
 
-func resolveBundledZeeMuxPath() (string, error) {
-	if exePath, err := os.Executable(); err == nil {
-		candidate := filepath.Join(filepath.Dir(exePath), "zeemux")
-		if isExecutableFile(candidate) {
-			return candidate, nil
-		}
-	}
-
-	if pathLookup, err := exec.LookPath("zeemux"); err == nil {
-		return pathLookup, nil
+// The commit input holds the summary on its first line and the description
+// below it, like an editor would.
+func (self *CommitGenerateButtonController) commitInputContent(message helpers.GeneratedCommitMessage) string {
+	if message.Description == "" {
+		return message.Summary
 	}
 
-	return "", fmt.Errorf("zeemux not found (expected `zeemux` next to lazygit or in PATH)")
-}
-
-func isExecutableFile(path string) bool {
-	info, err := os.Stat(path)
-	if err != nil {
-		return false
-	}
-	if !info.Mode().IsRegular() {
-		return false
-	}
-	return info.Mode()&0o111 != 0
+	return message.Summary + "\n\n" + message.Description
 }
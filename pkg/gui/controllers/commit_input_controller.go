@@ -68,6 +68,16 @@ func (self *CommitInputController) GetKeybindings(opts types.KeybindingsOpts) []
 			Key:     gocui.KeyCtrlJ,
 			Handler: self.insertNewline,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.CommitMessage.CommitMenu),
+			Handler:     self.openCommitMenu,
+			Description: self.c.Tr.CommitMenuTitle,
+			OpensMenu:   true,
+		},
+		{
+			Key:     opts.GetKey(opts.Config.Universal.Return),
+			Handler: self.cancelGeneration,
+		},
 	}
 }
 
@@ -105,6 +115,64 @@ func (self *CommitInputController) insertNewline() error {
 	view := self.c.Views().CommitInput
 	view.TextArea.TypeCharacter("\n")
 	view.RenderTextArea()
+	self.c.Helpers().CommitLint.RenderCommitInputViolations()
+	return nil
+}
+
+func (self *CommitInputController) openCommitMenu() error {
+	templatesHelper := self.c.Helpers().CommitTemplates
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommitMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.InsertCommitTemplate,
+				OnPress: func() error {
+					return templatesHelper.OpenTemplatesMenu(self.replaceMessage)
+				},
+				Key:       't',
+				Tooltip:   self.c.Tr.InsertCommitTemplateTooltip,
+				OpensMenu: true,
+			},
+			{
+				Label: self.c.Tr.PickCommitMessageFromHistory,
+				OnPress: func() error {
+					return templatesHelper.OpenHistoryMenu(self.replaceMessage)
+				},
+				Key:       'h',
+				Tooltip:   self.c.Tr.PickCommitMessageFromHistoryTooltip,
+				OpensMenu: true,
+			},
+			{
+				Label:          self.c.Tr.RunPreCommitHooks,
+				OnPress:        self.c.Helpers().PreCommitHooks.RunPreCommitHooks,
+				Key:            'r',
+				Tooltip:        self.c.Tr.RunPreCommitHooksTooltip,
+				OpensMenu:      true,
+				DisabledReason: self.c.Helpers().PreCommitHooks.GetDisabledReason(),
+			},
+		},
+	})
+}
+
+func (self *CommitInputController) replaceMessage(message string) error {
+	currentMessage := strings.TrimSpace(self.c.Views().CommitInput.TextArea.GetContent())
+	return self.c.ConfirmIf(currentMessage != "", types.ConfirmOpts{
+		Title:  self.c.Tr.ReplaceCommitMessageTitle,
+		Prompt: self.c.Tr.SureReplaceCommitMessage,
+		HandleConfirm: func() error {
+			self.c.Helpers().WorkingTree.SetCommitInputContent(message)
+			return nil
+		},
+	})
+}
+
+func (self *CommitInputController) cancelGeneration() error {
+	generatorHelper := self.c.Helpers().CommitGenerator
+	if !generatorHelper.IsGenerating() {
+		return gocui.ErrKeybindingNotHandled
+	}
+
+	generatorHelper.CancelGeneration()
 	return nil
 }
 
@@ -119,9 +187,7 @@ func (self *CommitInputController) confirm() error {
 
 	message := strings.TrimSpace(self.c.Views().CommitInput.TextArea.GetUnwrappedContent())
 	return self.c.Helpers().WorkingTree.CommitStagedWithMessage(message, false, func() error {
-		view := self.c.Views().CommitInput
-		view.ClearTextArea()
-		view.RenderTextArea()
+		self.c.Helpers().WorkingTree.ClearCommitInput()
 		return nil
 	})
 }
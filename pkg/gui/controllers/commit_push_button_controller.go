@@ -1,16 +1,27 @@
 package controllers
 
 import (
-	"fmt"
+	"errors"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
+// The commit and push button commits the message in the commit input, then
+// pushes the current branch, setting its upstream if it has none yet and
+// offering to pull with --rebase if the push is rejected. What happened in
+// each step is shown in a summary at the end.
 type CommitPushButtonController struct {
 	baseController
-	c          *ControllerCommon
-	handlePush func() error
+	c                 *ControllerCommon
+	customPushCommand func() (string, bool)
 }
 
 const (
@@ -20,11 +31,11 @@ const (
 
 var _ types.IController = &CommitPushButtonController{}
 
-func NewCommitPushButtonController(c *ControllerCommon, handlePush func() error) *CommitPushButtonController {
+func NewCommitPushButtonController(c *ControllerCommon, customPushCommand func() (string, bool)) *CommitPushButtonController {
 	return &CommitPushButtonController{
-		baseController: baseController{},
-		c:              c,
-		handlePush:     handlePush,
+		baseController:    baseController{},
+		c:                 c,
+		customPushCommand: customPushCommand,
 	}
 }
 
@@ -37,12 +48,12 @@ func (self *CommitPushButtonController) GetKeybindings(opts types.KeybindingsOpt
 		{
 			Key:         opts.GetKey(opts.Config.Universal.SubmitEditorText),
 			Handler:     self.submit,
-			Description: self.c.Tr.Actions.Push,
+			Description: self.c.Tr.CommitAndPush,
 		},
 		{
 			Key:         gocui.KeySpace,
 			Handler:     self.submit,
-			Description: self.c.Tr.Actions.Push,
+			Description: self.c.Tr.CommitAndPush,
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Universal.PrevBlock),
@@ -116,9 +127,192 @@ func (self *CommitPushButtonController) focusStagedFiles() error {
 }
 
 func (self *CommitPushButtonController) onClick(gocui.ViewMouseBindingOpts) error {
-	if self.handlePush == nil {
-		return fmt.Errorf("push action unavailable")
+	currentBranch := self.c.Helpers().Refs.GetCheckedOutRef()
+	if currentBranch == nil {
+		// need to wait for branches to refresh
+		return nil
 	}
+	if self.c.State().GetItemOperation(currentBranch) != types.ItemOperationNone {
+		return errors.New(self.c.Tr.CantPullOrPushSameBranchTwice)
+	}
+
+	run := &commitPushRun{}
+	message := strings.TrimSpace(self.c.Views().CommitInput.TextArea.GetUnwrappedContent())
+	if message == "" {
+		run.skipped(self.c.Tr.CommitPushStepNothingCommitted)
+		return self.push(run, currentBranch)
+	}
+
+	// Return focus to the input so users can continue editing after commit.
+	self.c.Context().Push(self.c.Contexts().CommitInput, types.OnFocusOpts{})
+
+	summary, _, _ := strings.Cut(message, "\n")
+	return self.c.Helpers().WorkingTree.CommitStagedWithMessageAndFailureHandler(message, false,
+		func() error {
+			run.succeeded(utils.ResolvePlaceholderString(self.c.Tr.CommitPushStepCommitted,
+				map[string]string{"summary": strings.TrimSpace(summary)}))
+			self.c.Helpers().WorkingTree.ClearCommitInput()
+			return self.push(run, currentBranch)
+		},
+		func(err error) error {
+			// This includes the commit being rejected by a hook
+			run.failed(self.c.Tr.CommitPushStepCommitFailed, err)
+			return self.finish(run)
+		})
+}
+
+func (self *CommitPushButtonController) push(run *commitPushRun, branch *models.Branch) error {
+	if customPushCmd, ok := self.customPushCommand(); ok {
+		return self.c.WithInlineStatus(branch, types.ItemOperationPushing, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.Push)
+			err := self.c.OS().Cmd.
+				NewShell(customPushCmd, self.c.UserConfig().OS.ShellFunctionsFile).
+				SetWd(self.c.Git().RepoPaths.RepoPath()).
+				PromptOnCredentialRequest(task).
+				Run()
+			if err != nil {
+				run.failed(self.c.Tr.CommitPushStepCustomPushCommandFailed, err)
+			} else {
+				run.succeeded(self.c.Tr.CommitPushStepRanCustomPushCommand)
+			}
+			return self.finish(run)
+		})
+	}
+
+	opts := git_commands.PushOpts{CurrentBranch: branch.Name}
+	pullOpts := git_commands.PullOptions{Rebase: true}
+	upstream := branch.ShortUpstreamRefName()
+	if !branch.IsTrackingRemote() {
+		remote := self.c.Helpers().Upstream.GetSuggestedRemote()
+		upstream = remote + "/" + branch.Name
+		opts.SetUpstream = true
+		// Without an upstream a plain pull doesn't know what to pull, so pull
+		// from the branch we are pushing to, even when push.default=current
+		// means we don't need to name it for the push
+		pullOpts.RemoteName = remote
+		pullOpts.BranchName = branch.Name
+		if !self.c.Git().Config.GetPushToCurrent() {
+			opts.UpstreamRemote = remote
+			opts.UpstreamBranch = branch.Name
+		}
+	}
+
+	return self.c.WithInlineStatus(branch, types.ItemOperationPushing, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
+		err := self.pushAux(task, run, opts, upstream)
+		if err != nil && strings.Contains(err.Error(), "Updates were rejected") {
+			self.offerToPullAndRetry(run, branch, opts, pullOpts, upstream)
+			return nil
+		}
+
+		return self.finish(run)
+	})
+}
+
+func (self *CommitPushButtonController) pushAux(task gocui.Task, run *commitPushRun, opts git_commands.PushOpts, upstream string) error {
+	self.c.LogAction(self.c.Tr.Actions.Push)
+	err := self.c.Git().Sync.Push(task, opts)
+	placeholders := map[string]string{"upstream": upstream}
+	switch {
+	case err != nil && strings.Contains(err.Error(), "Updates were rejected"):
+		run.failed(utils.ResolvePlaceholderString(self.c.Tr.CommitPushStepPushRejected, placeholders), nil)
+	case err != nil:
+		run.failed(utils.ResolvePlaceholderString(self.c.Tr.CommitPushStepPushFailed, placeholders), err)
+	case opts.SetUpstream:
+		run.succeeded(utils.ResolvePlaceholderString(self.c.Tr.CommitPushStepPushedAndSetUpstream, placeholders))
+	default:
+		run.succeeded(utils.ResolvePlaceholderString(self.c.Tr.CommitPushStepPushed, placeholders))
+	}
+	return err
+}
+
+func (self *CommitPushButtonController) offerToPullAndRetry(run *commitPushRun, branch *models.Branch, opts git_commands.PushOpts, pullOpts git_commands.PullOptions, upstream string) {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.PushRejectedTitle,
+		Prompt: self.c.Tr.PullRebaseAndRetryPrompt,
+		HandleConfirm: func() error {
+			return self.c.WithInlineStatus(branch, types.ItemOperationPulling, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.Pull)
+				err := self.c.Git().Sync.Pull(task, pullOpts)
+				if err != nil {
+					run.failed(self.c.Tr.CommitPushStepPullFailed, err)
+					// If the pull stopped with conflicts, the user needs to
+					// resolve them and continue the rebase before pushing
+					// again, so hand over to the conflict handling instead of
+					// showing the summary
+					if err := self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err); err != nil {
+						return self.finish(run)
+					}
+					return nil
+				}
+				run.succeeded(self.c.Tr.CommitPushStepPulled)
+
+				// Don't offer to pull again if the retry is rejected too
+				_ = self.pushAux(task, run, opts, upstream)
+				return self.finish(run)
+			})
+		},
+		HandleClose: func() error {
+			run.skipped(self.c.Tr.CommitPushStepNotPulled)
+			return self.finish(run)
+		},
+	})
+}
+
+func (self *CommitPushButtonController) finish(run *commitPushRun) error {
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	self.c.Alert(self.c.Tr.CommitAndPushSummaryTitle, run.summary())
+	return nil
+}
+
+type commitPushStepStatus int
+
+const (
+	commitPushStepSucceeded commitPushStepStatus = iota
+	commitPushStepFailed
+	commitPushStepSkipped
+)
+
+type commitPushStep struct {
+	description string
+	status      commitPushStepStatus
+	// the error output of a failed step
+	output string
+}
+
+// The steps of one run of the commit and push pipeline, for the summary
+type commitPushRun struct {
+	steps []commitPushStep
+}
+
+func (self *commitPushRun) succeeded(description string) {
+	self.steps = append(self.steps, commitPushStep{description: description, status: commitPushStepSucceeded})
+}
+
+func (self *commitPushRun) failed(description string, err error) {
+	output := ""
+	if err != nil {
+		output = strings.TrimSpace(err.Error())
+	}
+	self.steps = append(self.steps, commitPushStep{description: description, status: commitPushStepFailed, output: output})
+}
+
+func (self *commitPushRun) skipped(description string) {
+	self.steps = append(self.steps, commitPushStep{description: description, status: commitPushStepSkipped})
+}
 
-	return self.handlePush()
+func (self *commitPushRun) summary() string {
+	return strings.Join(lo.Map(self.steps, func(step commitPushStep, _ int) string {
+		switch step.status {
+		case commitPushStepFailed:
+			line := style.FgRed.Sprint("✗") + " " + step.description
+			if step.output != "" {
+				line += "\n" + step.output
+			}
+			return line
+		case commitPushStepSkipped:
+			return style.FgYellow.Sprint("-") + " " + step.description
+		default:
+			return style.FgGreen.Sprint("✓") + " " + step.description
+		}
+	}), "\n")
 }
@@ -0,0 +1,420 @@
+package controllers
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Runs a test command on commits, each in a temporary worktree, and keeps the
+// results by tree hash so that unchanged trees don't need to be tested again.
+type CommitTestingController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+
+	// The tests that are running in the background, if any
+	running atomic.Pointer[commitTestRun]
+}
+
+// Testing a number of commits with a command, which can be cancelled while
+// it's in progress
+type commitTestRun struct {
+	command    string
+	cancelled  atomic.Bool
+	runningCmd atomic.Pointer[exec.Cmd]
+}
+
+// Stops testing further commits, and kills the command that is testing the
+// current one, together with any processes it spawned
+func (self *commitTestRun) cancel() {
+	self.cancelled.Store(true)
+	if cmd := self.runningCmd.Load(); cmd != nil {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
+}
+
+var _ types.IController = &CommitTestingController{}
+
+func NewCommitTestingController(
+	c *ControllerCommon,
+) *CommitTestingController {
+	return &CommitTestingController{
+		baseController: baseController{},
+		c:              c,
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().LocalCommits,
+			c.Contexts().LocalCommits.GetSelected,
+			c.Contexts().LocalCommits.GetSelectedItems,
+		),
+	}
+}
+
+func (self *CommitTestingController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ViewTestOptions),
+			Handler:     self.withItems(self.openMenu),
+			Description: self.c.Tr.ViewCommitTestOptions,
+			Tooltip:     self.c.Tr.ViewCommitTestOptionsTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *CommitTestingController) openMenu(commits []*models.Commit) error {
+	if run := self.running.Load(); run != nil {
+		return self.openRunningMenu(run)
+	}
+
+	var todoSelected *types.DisabledReason
+	if lo.SomeBy(commits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		todoSelected = &types.DisabledReason{Text: self.c.Tr.CannotTestTodoCommits}
+	}
+
+	var noResults *types.DisabledReason
+	if len(self.resultsByTree(self.lastCommand())) == 0 {
+		noResults = &types.DisabledReason{Text: self.c.Tr.NoCommitTestResults}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommitTestsMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.TestSelectedCommits,
+				Tooltip: self.c.Tr.TestSelectedCommitsTooltip,
+				OnPress: func() error {
+					return self.withCommand(func(command string) error {
+						return self.test(commits, command, false)
+					})
+				},
+				DisabledReason: todoSelected,
+				Key:            't',
+			},
+			{
+				Label:   self.c.Tr.RetestSelectedCommits,
+				Tooltip: self.c.Tr.RetestSelectedCommitsTooltip,
+				OnPress: func() error {
+					return self.withCommand(func(command string) error {
+						return self.test(commits, command, true)
+					})
+				},
+				DisabledReason: todoSelected,
+				Key:            'r',
+			},
+			{
+				Label: self.c.Tr.TestSelectedCommitsWithCommand,
+				OnPress: func() error {
+					return self.promptForCommand(func(command string) error {
+						return self.test(commits, command, false)
+					})
+				},
+				DisabledReason: todoSelected,
+				Key:            'c',
+			},
+			{
+				Label:          self.c.Tr.ClearCommitTestResults,
+				Tooltip:        self.c.Tr.ClearCommitTestResultsTooltip,
+				OnPress:        self.clearResults,
+				DisabledReason: noResults,
+				Key:            'd',
+			},
+		},
+	})
+}
+
+// While tests are running, the menu only offers to cancel them
+func (self *CommitTestingController) openRunningMenu(run *commitTestRun) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.CommitTestsRunningTitle, map[string]string{
+			"command": run.command,
+		}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.CancelCommitTests,
+				OnPress: func() error {
+					run.cancel()
+					self.c.Toast(self.c.Tr.CommitTestsCancelled)
+					return nil
+				},
+				Key: 'c',
+			},
+		},
+	})
+}
+
+// The configured command takes precedence; otherwise we use the one that was
+// entered last, and ask for one if there is none
+func (self *CommitTestingController) withCommand(f func(command string) error) error {
+	if command := self.c.UserConfig().Git.CommitTestCommand; command != "" {
+		return f(command)
+	}
+
+	if command := self.lastCommand(); command != "" {
+		return f(command)
+	}
+
+	return self.promptForCommand(f)
+}
+
+func (self *CommitTestingController) promptForCommand(f func(command string) error) error {
+	shellCommandAction := &ShellCommandAction{c: self.c}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.CommitTestCommandPrompt,
+		InitialContent:      self.lastCommand(),
+		FindSuggestionsFunc: shellCommandAction.GetShellCommandsHistorySuggestionsFunc(),
+		AllowEditSuggestion: true,
+		PreserveWhitespace:  true,
+		HandleConfirm: func(command string) error {
+			shellCommandAction.addToHistory(command)
+
+			return f(command)
+		},
+		HandleDeleteSuggestion: shellCommandAction.deleteFromHistory,
+	})
+
+	return nil
+}
+
+func (self *CommitTestingController) test(commits []*models.Commit, command string, retest bool) error {
+	self.c.LogAction(self.c.Tr.Actions.TestCommits)
+
+	self.setLastCommand(command)
+
+	// Test the oldest commit first
+	hashes := lo.Reverse(lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Hash() }))
+
+	run := &commitTestRun{command: command}
+	self.running.Store(run)
+
+	return self.c.WithWaitingStatus(self.c.Tr.TestingCommitsStatus, func(gocui.Task) error {
+		defer self.running.Store(nil)
+
+		treeHashes, err := self.c.Git().CommitTesting.GetTreeHashes(hashes)
+		if err != nil {
+			return err
+		}
+
+		self.c.Mutexes().CommitTestsMutex.Lock()
+		for hash, treeHash := range treeHashes {
+			self.c.Model().CommitTreeHashes[hash] = treeHash
+		}
+		self.c.Mutexes().CommitTestsMutex.Unlock()
+
+		passed, failed := 0, 0
+		tested := map[string]bool{}
+		for _, hash := range hashes {
+			if run.cancelled.Load() {
+				break
+			}
+			treeHash, ok := treeHashes[hash]
+			if !ok {
+				// The commit no longer exists
+				continue
+			}
+
+			result := self.resultsByTree(command)[treeHash]
+			if tested[treeHash] || (!retest && result != nil && result.Status != models.CommitTestRunning) {
+				if result != nil && result.Failed() {
+					failed++
+				} else {
+					passed++
+				}
+				continue
+			}
+			tested[treeHash] = true
+
+			self.setResult(command, treeHash, &models.CommitTestResult{Status: models.CommitTestRunning})
+			result, err := self.testCommit(run, hash)
+			if err != nil {
+				self.setResult(command, treeHash, nil)
+				return err
+			}
+			if run.cancelled.Load() {
+				// The command was killed, so its result doesn't mean anything
+				self.setResult(command, treeHash, nil)
+				break
+			}
+			self.setResult(command, treeHash, result)
+
+			if result.Failed() {
+				failed++
+			} else {
+				passed++
+			}
+		}
+
+		if !run.cancelled.Load() {
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.CommitTestsDoneToast, map[string]string{
+				"passed": strconv.Itoa(passed),
+				"failed": strconv.Itoa(failed),
+			}))
+		}
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+		return nil
+	})
+}
+
+// Checks out the commit in a temporary worktree and runs the run's command in
+// it. A failing command isn't an error; it makes the commit fail.
+func (self *CommitTestingController) testCommit(run *commitTestRun, hash string) (*models.CommitTestResult, error) {
+	worktreePath, err := os.MkdirTemp(self.c.OS().GetTempDir(), "commit-test-")
+	if err != nil {
+		return nil, err
+	}
+
+	err = self.c.Git().Worktree.New(git_commands.NewWorktreeOpts{Path: worktreePath, Base: hash, Detach: true})
+	if err != nil {
+		_ = os.RemoveAll(worktreePath)
+		return nil, err
+	}
+	defer func() {
+		if err := self.c.Git().Worktree.Delete(worktreePath, true); err != nil {
+			self.c.Log.Error(err)
+		}
+	}()
+
+	output, err := self.runTestCommand(run, worktreePath)
+	result := &models.CommitTestResult{Status: models.CommitTestPassed, Output: output, TestedAt: time.Now()}
+	if err != nil {
+		result.Status = models.CommitTestFailed
+		if output == "" {
+			result.Output = err.Error()
+		}
+	}
+
+	return result, nil
+}
+
+// Runs the command in its own process group, so that cancelling the run can
+// kill it together with the processes it spawned, and returns its combined
+// output
+func (self *CommitTestingController) runTestCommand(run *commitTestRun, worktreePath string) (string, error) {
+	cmdObj := self.c.Git().CommitTesting.RunCmdObj(run.command, worktreePath)
+	var output bytes.Buffer
+	cmd := cmdObj.GetCmd()
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	oscommands.StartInNewProcessGroup(cmd)
+	// Don't wait for the output of processes that survive being killed
+	cmd.WaitDelay = time.Second
+
+	self.c.LogCommand(cmdObj.ToString(), true)
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	run.runningCmd.Store(cmd)
+	defer run.runningCmd.Store(nil)
+	// The run may have been cancelled before we stored the command
+	if run.cancelled.Load() {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
+
+	err := cmd.Wait()
+	return output.String(), err
+}
+
+func (self *CommitTestingController) clearResults() error {
+	self.c.LogAction(self.c.Tr.Actions.ClearCommitTestResults)
+
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	results := self.c.Model().CommitTestResults
+	delete(results.Results, results.LastCommand)
+	err := self.c.Git().CommitTesting.SaveResults(results)
+	self.c.Mutexes().CommitTestsMutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	self.applyResults()
+	return nil
+}
+
+func (self *CommitTestingController) lastCommand() string {
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	defer self.c.Mutexes().CommitTestsMutex.Unlock()
+
+	if self.c.Model().CommitTestResults == nil {
+		return ""
+	}
+	return self.c.Model().CommitTestResults.LastCommand
+}
+
+func (self *CommitTestingController) setLastCommand(command string) {
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	results := self.c.Model().CommitTestResults
+	changed := results.LastCommand != command
+	results.LastCommand = command
+	if err := self.c.Git().CommitTesting.SaveResults(results); err != nil {
+		self.c.Log.Error(err)
+	}
+	self.c.Mutexes().CommitTestsMutex.Unlock()
+
+	// Show the results of the new command instead of those of the old one
+	if changed {
+		self.applyResults()
+	}
+}
+
+// Returns a copy, so that it can be used while tests are running
+func (self *CommitTestingController) resultsByTree(command string) map[string]*models.CommitTestResult {
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	defer self.c.Mutexes().CommitTestsMutex.Unlock()
+
+	if self.c.Model().CommitTestResults == nil || command == "" {
+		return nil
+	}
+	return lo.Assign(self.c.Model().CommitTestResults.ForCommand(command))
+}
+
+// Stores the result of testing a tree (or removes it if result is nil) and
+// shows it on all commits with that tree
+func (self *CommitTestingController) setResult(command string, treeHash string, result *models.CommitTestResult) {
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	results := self.c.Model().CommitTestResults
+	if result == nil {
+		delete(results.ForCommand(command), treeHash)
+	} else {
+		results.ForCommand(command)[treeHash] = result
+	}
+	if err := self.c.Git().CommitTesting.SaveResults(results); err != nil {
+		self.c.Log.Error(err)
+	}
+	self.c.Mutexes().CommitTestsMutex.Unlock()
+
+	self.c.OnUIThread(func() error {
+		self.applyResults()
+		return nil
+	})
+}
+
+func (self *CommitTestingController) applyResults() {
+	for _, commit := range self.c.Model().Commits {
+		commit.TestResult = nil
+	}
+	self.c.Helpers().Refresh.LoadCommitTestResults(self.c.Model().Commits, self.context())
+	self.context().HandleRender()
+	if self.c.Context().Current() == self.context() {
+		self.context().HandleRenderToMain()
+	}
+}
+
+func (self *CommitTestingController) context() *context.LocalCommitsContext {
+	return self.c.Contexts().LocalCommits
+}
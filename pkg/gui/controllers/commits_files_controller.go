@@ -88,6 +88,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenDiffTool,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.blame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Select),
 			Handler:           self.withItems(self.toggleForPatch),
@@ -155,7 +162,7 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
 		task := types.NewRunPtyTask(cmdObj.GetCmd())
 
-		self.c.RenderToMainViews(types.RefreshMainOpts{
+		refreshOpts := types.RefreshMainOpts{
 			Pair: self.c.MainViewPairs().Normal,
 			Main: &types.ViewUpdateOpts{
 				Title:    self.c.Tr.Patch,
@@ -163,7 +170,21 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 				Task:     task,
 			},
 			Secondary: secondaryPatchPanelUpdateOpts(self.c),
-		})
+		}
+
+		if node.File != nil && node.File.IsLfs {
+			versions := helpers.LfsPointerDiffVersions{Old: from, New: to}
+			if reverse {
+				versions = helpers.LfsPointerDiffVersions{Old: to, New: from}
+			}
+			self.c.Helpers().Lfs.RenderPointerDiffs(refreshOpts, node.GetPath(), versions, nil, func() bool {
+				selected := self.context().GetSelected()
+				return selected != nil && selected.GetPath() == node.GetPath()
+			})
+			return
+		}
+
+		self.c.RenderToMainViews(refreshOpts)
 	}
 }
 
@@ -351,6 +372,23 @@ func (self *CommitFilesController) open(node *filetree.CommitFileNode) error {
 	return self.c.Helpers().Files.OpenFile(node.GetPath())
 }
 
+func (self *CommitFilesController) blame(node *filetree.CommitFileNode) error {
+	_, to := self.context().GetFromAndToForDiff()
+	return self.c.Helpers().Blame.BlameFile(node.GetPath(), to)
+}
+
+func (self *CommitFilesController) canBlame(node *filetree.CommitFileNode) *types.DisabledReason {
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	if node.File.Deleted() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDeletedFile}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) edit(nodes []*filetree.CommitFileNode) error {
 	return self.c.Helpers().Files.EditFiles(lo.FilterMap(nodes,
 		func(node *filetree.CommitFileNode, _ int) (string, bool) {
@@ -12,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -84,12 +85,27 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Handler:     self.c.Helpers().WorkingTree.HandleCommitEditorPress,
 			Description: self.c.Tr.CommitChangesWithEditor,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.RunPreCommitHooks),
+			Handler:           self.c.Helpers().PreCommitHooks.RunPreCommitHooks,
+			GetDisabledReason: self.c.Helpers().PreCommitHooks.GetDisabledReason,
+			Description:       self.c.Tr.RunPreCommitHooks,
+			Tooltip:           self.c.Tr.RunPreCommitHooksTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.FindBaseCommitForFixup),
 			Handler:     self.c.Helpers().FixupHelper.HandleFindBaseCommitForFixupPress,
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:     self.c.Helpers().FixupHelper.HandleAbsorbStagedChangesPress,
+			Description: self.c.Tr.AbsorbStagedChanges,
+			Tooltip:     self.c.Tr.AbsorbStagedChangesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Edit),
 			Handler:           self.withItems(self.edit),
@@ -168,6 +184,44 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			OpensMenu:       true,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:     opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler: self.withItem(self.c.Helpers().Blame.BlameWorkingTreeFile),
+			GetDisabledReason: self.require(
+				self.singleItemSelected(self.c.Helpers().Blame.GetDisabledReasonForWorkingTreeFile)),
+			Description: self.c.Tr.OpenBlame,
+			Tooltip:     self.c.Tr.OpenBlameTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewLfsOptions),
+			Handler:           self.withItem(self.c.Helpers().Lfs.OpenLfsMenu),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewLfsOptions,
+			Tooltip:           self.c.Tr.ViewLfsOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewSparseCheckoutOptions),
+			Handler:     self.c.Helpers().SparseCheckout.OpenMenu,
+			Description: self.c.Tr.ViewSparseCheckoutOptions,
+			Tooltip:     self.c.Tr.ViewSparseCheckoutOptionsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ApplyMailbox),
+			Handler:           self.c.Helpers().PatchSeries.ApplyMailbox,
+			GetDisabledReason: self.canApplyMailbox,
+			Description:       self.c.Tr.ApplyMailbox,
+			Tooltip:           self.c.Tr.ApplyMailboxTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewRerereOptions),
+			Handler:           self.openRerereMenu,
+			GetDisabledReason: self.canOpenRerereMenu,
+			Description:       self.c.Tr.ViewRerereOptions,
+			Tooltip:           self.c.Tr.ViewRerereOptionsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.ToggleTreeView),
 			Handler:     self.toggleTreeView,
@@ -332,11 +386,30 @@ func (self *FilesController) GetOnRenderToMain() func() {
 				}
 			}
 
+			if node.File != nil && node.File.IsLfs {
+				secondaryVersions := helpers.WorkingTreeLfsPointerDiffVersions(true, node.File.PreviousPath)
+				self.c.Helpers().Lfs.RenderPointerDiffs(
+					refreshOpts,
+					node.GetPath(),
+					helpers.WorkingTreeLfsPointerDiffVersions(mainShowsStaged, node.File.PreviousPath),
+					&secondaryVersions,
+					self.isStillSelected(node.GetPath()),
+				)
+				return
+			}
+
 			self.c.RenderToMainViews(refreshOpts)
 		})
 	}
 }
 
+func (self *FilesController) isStillSelected(path string) func() bool {
+	return func() bool {
+		node := self.context().GetSelected()
+		return node != nil && node.GetPath() == path
+	}
+}
+
 func (self *FilesController) GetOnClick() func() error {
 	return self.withItemGraceful(func(node *filetree.FileNode) error {
 		return self.press([]*filetree.FileNode{node})
@@ -1594,3 +1667,27 @@ func lastIndexByRune(s string, token string) int {
 
 	return -1
 }
+
+func (self *FilesController) canApplyMailbox() *types.DisabledReason {
+	if workingTreeState := self.c.Model().WorkingTreeStateAtLastCommitRefresh; workingTreeState.Any() {
+		return &types.DisabledReason{
+			Text: utils.ResolvePlaceholderString(self.c.Tr.CannotApplyMailboxWhile, map[string]string{
+				"operation": workingTreeState.LowerCaseTitle(self.c.Tr),
+			}),
+		}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openRerereMenu() error {
+	return self.c.Helpers().Rerere.OpenMenu(self.context().GetSelectedFile())
+}
+
+func (self *FilesController) canOpenRerereMenu() *types.DisabledReason {
+	if !self.c.Git().Rerere.IsEnabled() {
+		return &types.DisabledReason{Text: self.c.Tr.RerereNotEnabled}
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package controllers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type FilteringMenuAction struct {
@@ -13,17 +17,25 @@ type FilteringMenuAction struct {
 
 func (self *FilteringMenuAction) Call() error {
 	fileName := ""
+	// only set if the selected node is a file rather than a directory
+	filePath := ""
 	author := ""
 	switch self.c.Context().CurrentSide() {
 	case self.c.Contexts().Files:
 		node := self.c.Contexts().Files.GetSelected()
 		if node != nil {
 			fileName = node.GetPath()
+			if node.File != nil {
+				filePath = fileName
+			}
 		}
 	case self.c.Contexts().CommitFiles:
 		node := self.c.Contexts().CommitFiles.GetSelected()
 		if node != nil {
 			fileName = node.GetPath()
+			if node.File != nil {
+				filePath = fileName
+			}
 		}
 	case self.c.Contexts().LocalCommits:
 		commit := self.c.Contexts().LocalCommits.GetSelected()
@@ -48,6 +60,35 @@ func (self *FilteringMenuAction) Call() error {
 		})
 	}
 
+	if selection := self.selectedLines(); selection != nil {
+		start, end := selection.state.SelectedLineRange(selection.newFile)
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.FilterByLinesOption, map[string]string{
+				"start": fmt.Sprint(start),
+				"end":   fmt.Sprint(end),
+				"path":  selection.path,
+			}),
+			OnPress: func() error {
+				return self.setFilteringLineRange(fmt.Sprintf("%d,%d", start, end), selection.path, selection.revision)
+			},
+			DisabledReason: selection.disabledReason,
+			Tooltip:        lo.Ternary(tooltip != "", tooltip, self.c.Tr.FilterByLinesTooltip),
+		})
+
+		if function := selection.state.SelectedHunkContext(); function != "" {
+			menuItems = append(menuItems, &types.MenuItem{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.FilterByFunctionOption, map[string]string{
+					"function": function,
+					"path":     selection.path,
+				}),
+				OnPress: func() error {
+					return self.setFilteringLineRange(":"+escapeBasicRegex(function), selection.path, selection.revision)
+				},
+				Tooltip: tooltip,
+			})
+		}
+	}
+
 	if author != "" {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label: fmt.Sprintf("%s '%s'", self.c.Tr.FilterBy, author),
@@ -74,6 +115,25 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	if filePath != "" {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.FilterFunctionOption, map[string]string{
+				"path": filePath,
+			}),
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: self.c.Tr.EnterFunctionName,
+					HandleConfirm: func(response string) error {
+						return self.setFilteringLineRange(":"+response, filePath, "")
+					},
+				})
+
+				return nil
+			},
+			Tooltip: tooltip,
+		})
+	}
+
 	menuItems = append(menuItems, &types.MenuItem{
 		Label: self.c.Tr.FilterAuthorOption,
 		OnPress: func() error {
@@ -90,6 +150,19 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	searchTooltip := self.c.Tr.FilterSearchTooltip
+	menuItems = append(menuItems,
+		self.searchMenuItem(self.c.Tr.FilterPickaxeOption, self.c.Tr.EnterPickaxeString, searchTooltip, func(response string) {
+			self.c.Modes().Filtering.SetPickaxe(response, false)
+		}),
+		self.searchMenuItem(self.c.Tr.FilterPickaxeRegexOption, self.c.Tr.EnterPickaxeRegex, searchTooltip, func(response string) {
+			self.c.Modes().Filtering.SetPickaxe(response, true)
+		}),
+		self.searchMenuItem(self.c.Tr.FilterMessageOption, self.c.Tr.EnterMessagePattern, searchTooltip, func(response string) {
+			self.c.Modes().Filtering.SetMessagePattern(response)
+		}),
+	)
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
@@ -112,6 +185,100 @@ func (self *FilteringMenuAction) setFilteringAuthor(author string) error {
 	return self.setFiltering()
 }
 
+// Unlike the other filters, searches don't cancel the path and author filters
+// but combine with them, e.g. to find the commits that added a string to a file
+func (self *FilteringMenuAction) searchMenuItem(label string, promptTitle string, tooltip string, setSearch func(response string)) *types.MenuItem {
+	return &types.MenuItem{
+		Label: label,
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: promptTitle,
+				HandleConfirm: func(response string) error {
+					// git log -L doesn't support searching
+					self.c.Modes().Filtering.SetLineRange("", "", "")
+					setSearch(response)
+					return self.setFiltering()
+				},
+			})
+
+			return nil
+		},
+		Tooltip: tooltip,
+	}
+}
+
+func (self *FilteringMenuAction) setFilteringLineRange(lineRange string, path string, revision string) error {
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetLineRange(lineRange, path, revision)
+	return self.setFiltering()
+}
+
+// The lines selected in the staging or patch building view, along with the
+// revision whose version of the file the line numbers refer to
+type lineSelection struct {
+	path    string
+	state   *patch_exploring.State
+	newFile bool
+	// empty for HEAD
+	revision string
+	// set if we can't tell which lines of the revision the selection refers to
+	disabledReason *types.DisabledReason
+}
+
+// If the staging or patch building view is focused, returns the file shown in
+// it along with the view's state, so that we can follow the history of the
+// selected lines. git log -L interprets the line numbers against the revision
+// that it starts from, so:
+//   - the staged changes are a diff against HEAD, so we take the old side's
+//     line numbers
+//   - the unstaged changes are a diff against the index, whose line numbers
+//     are only HEAD's if the file has no staged changes
+//   - the patch building view shows a commit's changes, so we take the new
+//     side's line numbers and start from that commit
+func (self *FilteringMenuAction) selectedLines() *lineSelection {
+	var selection *lineSelection
+	switch self.c.Context().Current() {
+	case self.c.Contexts().Staging:
+		selection = &lineSelection{
+			path:  self.c.Contexts().Files.GetSelectedPath(),
+			state: self.c.Contexts().Staging.GetState(),
+		}
+		if file := self.c.Contexts().Files.GetSelectedFile(); file != nil && file.HasStagedChanges {
+			selection.disabledReason = &types.DisabledReason{Text: self.c.Tr.FilterByUnstagedLinesWithStagedChanges}
+		}
+	case self.c.Contexts().StagingSecondary:
+		selection = &lineSelection{
+			path:  self.c.Contexts().Files.GetSelectedPath(),
+			state: self.c.Contexts().StagingSecondary.GetState(),
+		}
+	case self.c.Contexts().CustomPatchBuilder:
+		selection = &lineSelection{
+			path:     self.c.Contexts().CommitFiles.GetSelectedPath(),
+			state:    self.c.Contexts().CustomPatchBuilder.GetState(),
+			newFile:  true,
+			revision: self.c.Contexts().CommitFiles.RefForAdjustingLineNumberInDiff(),
+		}
+	}
+
+	if selection == nil || selection.state == nil || selection.path == "" {
+		return nil
+	}
+	return selection
+}
+
+// git log -L :funcname interprets funcname as a basic regular expression, and
+// ends it at the first colon that isn't escaped
+func escapeBasicRegex(str string) string {
+	var result strings.Builder
+	for _, r := range str {
+		if strings.ContainsRune(`.[]*^$\:`, r) {
+			result.WriteRune('\\')
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
 func (self *FilteringMenuAction) setFiltering() error {
 	self.c.Modes().Filtering.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
 
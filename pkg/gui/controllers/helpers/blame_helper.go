@@ -0,0 +1,183 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type BlameHelper struct {
+	c *HelperCommon
+
+	// Whether the main panel was split before we opened the blame view, so
+	// that we can restore it when leaving
+	splitMainPanelBeforeBlame bool
+}
+
+func NewBlameHelper(c *HelperCommon) *BlameHelper {
+	return &BlameHelper{
+		c: c,
+	}
+}
+
+// BlameFile shows the blame of the given file in the main view, as of the
+// given commit, or as in the working tree if the commit is empty.
+func (self *BlameHelper) BlameFile(path string, commit string) error {
+	target := context.BlameTarget{Path: path, Commit: commit}
+	options := self.c.Contexts().Blame.GetOptions()
+
+	return self.loadBlame(target, options, 0, func() {
+		self.c.Contexts().Blame.Reset(target)
+	})
+}
+
+// BlameWorkingTreeFile blames a file from the files panel as it is in the
+// working tree
+func (self *BlameHelper) BlameWorkingTreeFile(node *filetree.FileNode) error {
+	if reason := self.GetDisabledReasonForWorkingTreeFile(node); reason != nil {
+		return errors.New(reason.Text)
+	}
+
+	// A deleted file can't be blamed in the working tree, so show how it was
+	// before it got deleted
+	commit := ""
+	if node.File.Deleted {
+		commit = "HEAD"
+	}
+	return self.BlameFile(node.GetPath(), commit)
+}
+
+func (self *BlameHelper) GetDisabledReasonForWorkingTreeFile(node *filetree.FileNode) *types.DisabledReason {
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotBlameDirectory}
+	}
+
+	if !node.File.Tracked || node.File.Added {
+		return &types.DisabledReason{Text: self.c.Tr.NoHistoryToBlame}
+	}
+
+	return nil
+}
+
+// BlameParentCommit blames the file as of the parent of the commit that last
+// changed the given line, so that we can see where the line came from.
+func (self *BlameHelper) BlameParentCommit(line *models.BlameLine) error {
+	if line.PreviousHash == "" {
+		return errors.New(self.c.Tr.BlameLineHasNoParent)
+	}
+
+	target := context.BlameTarget{Path: line.PreviousPath, Commit: line.PreviousHash}
+	options := self.c.Contexts().Blame.GetOptions()
+
+	// We don't know which line of the parent this line corresponds to, but its
+	// line number in the blamed commit is usually a good approximation
+	return self.loadBlame(target, options, line.OriginalLineNumber-1, func() {
+		self.c.Contexts().Blame.PushTarget(target)
+	})
+}
+
+// GoBack returns to the blame we showed before blaming a parent commit, or
+// leaves the blame view if there is none.
+func (self *BlameHelper) GoBack() error {
+	blameContext := self.c.Contexts().Blame
+	target, selectedLineIdx, ok := blameContext.PeekPreviousTarget()
+	if !ok {
+		self.restoreSplitMainPanel()
+		self.c.Context().Pop()
+		return nil
+	}
+
+	return self.loadBlame(target, blameContext.GetOptions(), selectedLineIdx, func() {
+		blameContext.PopTarget()
+	})
+}
+
+// SetOptions blames the current target again with the given options
+func (self *BlameHelper) SetOptions(options git_commands.BlameOptions) error {
+	blameContext := self.c.Contexts().Blame
+
+	return self.loadBlame(blameContext.GetTarget(), options, blameContext.GetSelectedLineIdx(), func() {
+		blameContext.SetOptions(options)
+	})
+}
+
+// GoToCommit selects the given commit in the commits panel and focuses it
+func (self *BlameHelper) GoToCommit(hash string) error {
+	commitsContext := self.c.Contexts().LocalCommits
+	if commitsContext.SelectCommitByHash(hash) {
+		self.focusCommits()
+		return nil
+	}
+
+	if !commitsContext.GetLimitCommits() {
+		return errors.New(self.c.Tr.CommitNotInCommitsPanel)
+	}
+
+	// The commit may be older than the ones we loaded so far
+	commitsContext.SetLimitCommits(false)
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+
+		self.c.OnUIThread(func() error {
+			if !commitsContext.SelectCommitByHash(hash) {
+				return errors.New(self.c.Tr.CommitNotInCommitsPanel)
+			}
+
+			self.focusCommits()
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *BlameHelper) focusCommits() {
+	self.restoreSplitMainPanel()
+	self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+	self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
+}
+
+// Runs git blame for the given target and options, and only if that succeeds
+// calls onLoaded to update the state of the blame context, and shows the
+// result.
+func (self *BlameHelper) loadBlame(
+	target context.BlameTarget,
+	options git_commands.BlameOptions,
+	selectedLineIdx int,
+	onLoaded func(),
+) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingBlame, func(gocui.Task) error {
+		blameLines, err := self.c.Git().Blame.GetBlame(target.Path, target.Commit, options)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			blameContext := self.c.Contexts().Blame
+			onLoaded()
+			self.c.Model().BlameLines = blameLines
+			blameContext.SetSelection(selectedLineIdx)
+			blameContext.UpdateTitle()
+			blameContext.ClearSearchString()
+			blameContext.GetView().ClearSearch()
+			self.c.PostRefreshUpdate(blameContext)
+
+			if self.c.Context().Current() != blameContext {
+				// The blame view takes up the whole main window
+				self.splitMainPanelBeforeBlame = self.c.State().GetRepoState().GetSplitMainPanel()
+				self.c.State().GetRepoState().SetSplitMainPanel(false)
+				self.c.Context().Push(blameContext, types.OnFocusOpts{})
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *BlameHelper) restoreSplitMainPanel() {
+	self.c.State().GetRepoState().SetSplitMainPanel(self.splitMainPanelBeforeBlame)
+}
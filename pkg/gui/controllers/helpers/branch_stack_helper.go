@@ -0,0 +1,283 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+type BranchStackHelper struct {
+	c              *HelperCommon
+	refsHelper     *RefsHelper
+	mergeAndRebase *MergeAndRebaseHelper
+}
+
+func NewBranchStackHelper(
+	c *HelperCommon,
+	refsHelper *RefsHelper,
+	mergeAndRebase *MergeAndRebaseHelper,
+) *BranchStackHelper {
+	return &BranchStackHelper{
+		c:              c,
+		refsHelper:     refsHelper,
+		mergeAndRebase: mergeAndRebase,
+	}
+}
+
+func (self *BranchStackHelper) GetDisabledReason() *types.DisabledReason {
+	if !self.c.Model().BranchStack.Contains(self.c.Model().CheckedOutBranch) {
+		return &types.DisabledReason{Text: self.c.Tr.NotInBranchStack}
+	}
+
+	return nil
+}
+
+// OpenMenu shows the branches of the stack from top to bottom, followed by
+// the actions for the whole stack. The selected commit, if any, is where a
+// new branch can be inserted into the stack.
+func (self *BranchStackHelper) OpenMenu(selectedCommit *models.Commit) error {
+	stack := self.c.Model().BranchStack
+
+	menuItems := lo.Map(lo.Reverse(append([]*models.StackBranch{}, stack.Branches...)),
+		func(stackBranch *models.StackBranch, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				LabelColumns: self.stackBranchColumns(stackBranch),
+				OnPress: func() error {
+					return self.refsHelper.CheckoutRef(stackBranch.Name, types.CheckoutRefOptions{})
+				},
+			}
+		})
+
+	var cannotRestack *types.DisabledReason
+	if _, found := stack.FirstBranchNeedingRestack(); !found {
+		cannotRestack = &types.DisabledReason{Text: self.c.Tr.BranchStackUpToDate}
+	}
+
+	insertBranchItem := &types.MenuItem{
+		Label:   self.c.Tr.InsertBranchIntoStack,
+		Tooltip: self.c.Tr.InsertBranchIntoStackTooltip,
+		Key:     'n',
+	}
+	if disabledReason := self.canInsertBranchAt(selectedCommit); disabledReason != nil {
+		insertBranchItem.DisabledReason = disabledReason
+	} else {
+		insertBranchItem.OnPress = func() error {
+			return self.insertBranch(selectedCommit)
+		}
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label:          self.c.Tr.RestackBranches,
+			Tooltip:        self.c.Tr.RestackBranchesTooltip,
+			OnPress:        self.restack,
+			DisabledReason: cannotRestack,
+			Key:            'r',
+		},
+		&types.MenuItem{
+			Label:   self.c.Tr.PushBranchStack,
+			Tooltip: self.c.Tr.PushBranchStackTooltip,
+			OnPress: self.pushStack,
+			Key:     'p',
+		},
+		insertBranchItem,
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.BranchStackTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *BranchStackHelper) stackBranchColumns(stackBranch *models.StackBranch) []string {
+	name := stackBranch.Name
+	if name == self.c.Model().CheckedOutBranch {
+		name = style.FgGreen.Sprint("* " + name)
+	} else {
+		name = "  " + name
+	}
+
+	divergence := style.FgCyan.Sprintf(self.c.Tr.BranchStackAhead, stackBranch.Ahead)
+	if stackBranch.Behind > 0 {
+		divergence += " " + style.FgYellow.Sprintf(self.c.Tr.BranchStackBehindBase, stackBranch.Behind)
+	}
+
+	pushStatus := ""
+	branch, found := self.findBranch(stackBranch.Name)
+	if found {
+		if branch.IsTrackingRemote() {
+			pushStatus = presentation.BranchStatus(branch, types.ItemOperationNone, self.c.Tr, time.Now(), self.c.UserConfig())
+		} else {
+			pushStatus = style.FgRed.Sprint(self.c.Tr.BranchStackNotPushed)
+		}
+	}
+
+	return []string{name, divergence, pushStatus}
+}
+
+func (self *BranchStackHelper) findBranch(name string) (*models.Branch, bool) {
+	return lo.Find(self.c.Model().Branches, func(b *models.Branch) bool { return b.Name == name })
+}
+
+// Rebases the branches whose base has moved on since they were created, e.g.
+// because the base was amended or rebased onto an updated main branch. We
+// rebase the top branch so that the branches in between move along with it.
+func (self *BranchStackHelper) restack() error {
+	self.c.LogAction(self.c.Tr.Actions.RestackBranches)
+	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
+		originalBranch := self.c.Model().CheckedOutBranch
+		stack := self.c.Model().BranchStack
+
+		// Each rebase moves all branches above the restacked one, so the
+		// number of rebases is limited by the number of branches
+		for range stack.Branches {
+			stackBranch, found := stack.FirstBranchNeedingRestack()
+			if !found {
+				break
+			}
+
+			topBranch := stack.Branches[len(stack.Branches)-1].Name
+			if err := self.checkout(topBranch); err != nil {
+				return err
+			}
+
+			err := self.c.Git().Rebase.RebaseStack(stackBranch.Base, stackBranch.ForkPoint)
+			if err := self.mergeAndRebase.CheckMergeOrRebase(err); err != nil {
+				return err
+			}
+			if self.c.Git().Status.WorkingTreeState().Any() {
+				// We stopped because of conflicts; once the user continues the
+				// rebase, they can restack the remaining branches
+				return nil
+			}
+
+			branches, err := self.c.Git().Loaders.BranchLoader.Load(
+				nil, self.c.Model().MainBranches, nil, false, nil, nil)
+			if err != nil {
+				return err
+			}
+			stack, err = self.c.Git().BranchStack.GetStack(branches, topBranch, self.c.Model().MainBranches)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := self.checkout(originalBranch); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI})
+		return nil
+	})
+}
+
+func (self *BranchStackHelper) checkout(branchName string) error {
+	currentBranch, err := self.c.Git().Branch.CurrentBranchName()
+	if err != nil || currentBranch == branchName {
+		return err
+	}
+
+	return self.c.Git().Branch.Checkout(branchName, git_commands.CheckoutOptions{})
+}
+
+// Pushes all branches of the stack from the bottom up, with --force-with-lease
+// because restacking rewrites their commits. Branches without an upstream are
+// pushed to a new branch of the same name on the default remote.
+func (self *BranchStackHelper) pushStack() error {
+	stack := self.c.Model().BranchStack
+
+	defaultRemote := ""
+	if len(self.c.Model().Remotes) > 0 {
+		defaultRemote = self.c.Model().Remotes[0].Name
+		if lo.ContainsBy(self.c.Model().Remotes, func(r *models.Remote) bool { return r.Name == "origin" }) {
+			defaultRemote = "origin"
+		}
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.PushBranchStack)
+	return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+		for _, stackBranch := range stack.Branches {
+			branch, found := self.findBranch(stackBranch.Name)
+			if !found || branch.MatchesUpstream() {
+				continue
+			}
+
+			opts := git_commands.PushOpts{
+				ForceWithLease: true,
+				CurrentBranch:  branch.Name,
+				UpstreamRemote: branch.UpstreamRemote,
+				UpstreamBranch: branch.UpstreamBranch,
+			}
+			if !branch.IsTrackingRemote() {
+				if defaultRemote == "" {
+					return errors.New(self.c.Tr.NoRemotesToPushStackTo)
+				}
+				opts.UpstreamRemote = defaultRemote
+				opts.UpstreamBranch = branch.Name
+				opts.SetUpstream = true
+			}
+
+			if err := self.c.Git().Sync.Push(task, opts); err != nil {
+				return fmt.Errorf("%s: %w", branch.Name, err)
+			}
+		}
+
+		self.c.Refresh(types.RefreshOptions{
+			Mode:  types.ASYNC,
+			Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES},
+		})
+		return nil
+	})
+}
+
+// A new branch can be inserted at any commit of the stack that isn't already
+// the head of a branch
+func (self *BranchStackHelper) canInsertBranchAt(commit *models.Commit) *types.DisabledReason {
+	if commit == nil {
+		return &types.DisabledReason{Text: self.c.Tr.InsertBranchIntoStackFromCommitsPanel}
+	}
+
+	if commit.IsTODO() || commit.Status == models.StatusMerged {
+		return &types.DisabledReason{Text: self.c.Tr.CannotInsertBranchAtCommit}
+	}
+
+	if lo.ContainsBy(self.c.Model().Branches, func(b *models.Branch) bool { return b.CommitHash == commit.Hash() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CommitIsAlreadyBranchHead}
+	}
+
+	return nil
+}
+
+func (self *BranchStackHelper) insertBranch(commit *models.Commit) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: fmt.Sprintf(self.c.Tr.InsertBranchIntoStackPrompt, commit.ShortHash()),
+		HandleConfirm: func(response string) error {
+			name := SanitizedBranchName(strings.TrimSpace(response))
+			if name == "" {
+				return nil
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.InsertBranchIntoStack)
+			if err := self.c.Git().Branch.NewWithoutCheckout(name, commit.Hash()); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{
+				Mode:  types.ASYNC,
+				Scope: []types.RefreshableView{types.BRANCHES},
+			})
+			return nil
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,636 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The number of recent commit subjects that we pass to a generator so that it
+// can pick up the style of the repo's existing messages.
+const commitGeneratorRecentSubjectsCount = 10
+
+type CommitGeneratorHelper struct {
+	c             *HelperCommon
+	commitsHelper *CommitsHelper
+
+	// The generation that is currently running, if any
+	currentTask atomic.Pointer[CommitGenerationTask]
+}
+
+// CommitGenerationTask is a background task that generates one or more commit
+// messages. Cancelling it terminates the generator that is running for it, and
+// makes it fail to generate any further messages.
+type CommitGenerationTask struct {
+	cancelled  atomic.Bool
+	runningCmd atomic.Pointer[exec.Cmd]
+}
+
+func (self *CommitGenerationTask) Cancel() {
+	self.cancelled.Store(true)
+	if cmd := self.runningCmd.Load(); cmd != nil {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
+}
+
+func (self *CommitGenerationTask) Cancelled() bool {
+	return self.cancelled.Load()
+}
+
+var ErrCommitGenerationCancelled = errors.New("commit message generation cancelled")
+
+func NewCommitGeneratorHelper(c *HelperCommon, commitsHelper *CommitsHelper) *CommitGeneratorHelper {
+	return &CommitGeneratorHelper{
+		c:             c,
+		commitsHelper: commitsHelper,
+	}
+}
+
+// This is what a generator receives as JSON on stdin.
+type CommitGeneratorInput struct {
+	Branch         string   `json:"branch"`
+	Diff           string   `json:"diff"`
+	RecentSubjects []string `json:"recentSubjects"`
+}
+
+// A generator may print this as JSON instead of a plain-text message.
+type GeneratedCommitMessage struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+}
+
+// Generators returns the configured generators, or the bundled zeemux
+// generator if none are configured.
+func (self *CommitGeneratorHelper) Generators() []config.CommitGeneratorCommand {
+	if commands := self.c.UserConfig().Git.Commit.Generator.Commands; len(commands) > 0 {
+		return commands
+	}
+
+	return []config.CommitGeneratorCommand{
+		{
+			Name:    "zeemux",
+			Command: "{{.ZeeMuxPath | quote}} llm commit-msg --provider qwen --repo {{.RepoPath | quote}}",
+		},
+	}
+}
+
+// WithGenerator calls f with the generator to use. If more than one generator
+// is configured, the user is asked to pick one first; the choice is remembered
+// in the app state and preselected next time.
+func (self *CommitGeneratorHelper) WithGenerator(f func(generator config.CommitGeneratorCommand) error) error {
+	generators := self.Generators()
+	if len(generators) == 1 {
+		return f(generators[0])
+	}
+
+	lastUsed := self.c.GetAppState().LastCommitGenerator
+	menuItems := lo.Map(generators, func(generator config.CommitGeneratorCommand, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:  generator.Name,
+			Widget: types.MakeMenuRadioButton(generator.Name == lastUsed),
+			OnPress: func() error {
+				self.c.GetAppState().LastCommitGenerator = generator.Name
+				self.c.SaveAppStateAndLogError()
+				return f(generator)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommitGeneratorMenuTitle,
+		Items: menuItems,
+	})
+}
+
+// StagedChangesInput collects the generator input for the currently staged
+// changes.
+func (self *CommitGeneratorHelper) StagedChangesInput() (CommitGeneratorInput, error) {
+	diff, err := self.c.Git().Diff.GetDiff(true)
+	if err != nil {
+		return CommitGeneratorInput{}, err
+	}
+
+	if strings.TrimSpace(diff) == "" {
+		return CommitGeneratorInput{}, errors.New(self.c.Tr.NoStagedChangesToGenerateFrom)
+	}
+
+	generatorConfig := self.c.UserConfig().Git.Commit.Generator
+	return CommitGeneratorInput{
+		Branch:         self.c.Model().CheckedOutBranch,
+		Diff:           TrimDiffToBudget(diff, generatorConfig.MaxDiffBytes, generatorConfig.LowPriorityFiles),
+		RecentSubjects: self.recentSubjects(),
+	}, nil
+}
+
+// CommitInput collects the generator input for an existing commit, so that
+// its message can be regenerated.
+func (self *CommitGeneratorHelper) CommitInput(commit *models.Commit) (CommitGeneratorInput, error) {
+	diff, err := self.c.Git().Commit.GetCommitDiff(commit.Hash())
+	if err != nil {
+		return CommitGeneratorInput{}, err
+	}
+
+	generatorConfig := self.c.UserConfig().Git.Commit.Generator
+	return CommitGeneratorInput{
+		Branch:         self.c.Model().CheckedOutBranch,
+		Diff:           TrimDiffToBudget(diff, generatorConfig.MaxDiffBytes, generatorConfig.LowPriorityFiles),
+		RecentSubjects: self.recentSubjects(),
+	}, nil
+}
+
+func (self *CommitGeneratorHelper) recentSubjects() []string {
+	commits := self.c.Model().Commits
+	subjects := make([]string, 0, commitGeneratorRecentSubjectsCount)
+	for _, commit := range commits {
+		if len(subjects) == commitGeneratorRecentSubjectsCount {
+			break
+		}
+		if commit.IsTODO() {
+			continue
+		}
+		subjects = append(subjects, commit.Name)
+	}
+	return subjects
+}
+
+// RunGeneration runs f in the background as a commit generation task, showing
+// the given waiting status while it runs. Only one generation can run at a
+// time; the user can cancel it with the Return key (see CancelGeneration).
+func (self *CommitGeneratorHelper) RunGeneration(statusText string, f func(task *CommitGenerationTask) error) error {
+	task := &CommitGenerationTask{}
+	if !self.currentTask.CompareAndSwap(nil, task) {
+		return errors.New(self.c.Tr.CommitGenerationInProgress)
+	}
+
+	return self.c.WithWaitingStatus(statusText, func(gocui.Task) error {
+		defer self.currentTask.CompareAndSwap(task, nil)
+
+		return f(task)
+	})
+}
+
+// Generate runs the given generator with the given input as part of the given
+// task, and returns the commit message that it produced. onOutput is called
+// with the output received so far each time the generator writes more of it.
+// Returns ErrCommitGenerationCancelled if the task is cancelled. Must not be
+// called on the UI thread.
+func (self *CommitGeneratorHelper) Generate(
+	task *CommitGenerationTask,
+	generator config.CommitGeneratorCommand,
+	input CommitGeneratorInput,
+	onOutput func(string),
+) (GeneratedCommitMessage, error) {
+	if task.Cancelled() {
+		return GeneratedCommitMessage{}, ErrCommitGenerationCancelled
+	}
+
+	cmdObj, err := self.generatorCmdObj(generator, input)
+	if err != nil {
+		return GeneratedCommitMessage{}, err
+	}
+
+	output := &streamingOutput{onOutput: onOutput}
+	var stderr bytes.Buffer
+	cmd := cmdObj.GetCmd()
+	cmd.Stdout = output
+	cmd.Stderr = &stderr
+	// The command runs in a shell, so we terminate the whole process group to
+	// stop the processes it spawned too; and in case some of them survive,
+	// we don't let them keep us waiting for their output.
+	oscommands.StartInNewProcessGroup(cmd)
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Start(); err != nil {
+		return GeneratedCommitMessage{}, err
+	}
+	task.runningCmd.Store(cmd)
+	defer task.runningCmd.Store(nil)
+	// The task may have been cancelled before we stored the command
+	if task.Cancelled() {
+		_ = oscommands.TerminateProcessGroup(cmd)
+	}
+
+	var timedOut atomic.Bool
+	if generator.Timeout > 0 {
+		timer := time.AfterFunc(generator.TimeoutDuration(), func() {
+			timedOut.Store(true)
+			_ = oscommands.TerminateProcessGroup(cmd)
+		})
+		defer timer.Stop()
+	}
+
+	err = cmd.Wait()
+	if task.Cancelled() {
+		return GeneratedCommitMessage{}, ErrCommitGenerationCancelled
+	}
+	if err != nil {
+		if timedOut.Load() {
+			return GeneratedCommitMessage{}, errors.New(utils.ResolvePlaceholderString(self.c.Tr.CommitGeneratorTimedOut,
+				map[string]string{"name": generator.Name, "seconds": fmt.Sprint(generator.Timeout)}))
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return GeneratedCommitMessage{}, fmt.Errorf("%s: %s", generator.Name, msg)
+		}
+		return GeneratedCommitMessage{}, fmt.Errorf("%s: %w", generator.Name, err)
+	}
+
+	message := ParseGeneratedCommitMessage(output.buf.String())
+	if message.Summary == "" {
+		return GeneratedCommitMessage{}, errors.New(self.c.Tr.CommitGeneratorEmptyMessage)
+	}
+
+	return message, nil
+}
+
+// GenerateIntoCommitMessagePanel lets the user pick a generator and fills the
+// summary and description of the commit message panel with what it generates
+// from the staged changes.
+func (self *CommitGeneratorHelper) GenerateIntoCommitMessagePanel() error {
+	if self.IsGenerating() {
+		return errors.New(self.c.Tr.CommitGenerationInProgress)
+	}
+
+	return self.WithGenerator(func(generator config.CommitGeneratorCommand) error {
+		return self.RunGeneration(self.GeneratingStatus(self.c.Tr.GeneratingCommitMessageStatus), func(task *CommitGenerationTask) error {
+			message, err := self.GenerateFromStagedChanges(task, generator, func(string) {})
+			self.c.OnUIThread(func() error {
+				// The panel may have been closed in the meantime, in which case
+				// the generation was cancelled and there is nothing to fill in.
+				if !self.c.Context().IsCurrentOrParent(self.c.Contexts().CommitMessage) &&
+					!self.c.Context().IsCurrentOrParent(self.c.Contexts().CommitDescription) {
+					return nil
+				}
+				if err != nil {
+					if errors.Is(err, ErrCommitGenerationCancelled) {
+						self.c.Toast(self.c.Tr.CommitGenerationCancelled)
+					} else {
+						self.c.ErrorToast(err.Error())
+					}
+					return nil
+				}
+
+				self.commitsHelper.SetMessageAndDescriptionInView(message.Summary + "\n" + message.Description)
+				return nil
+			})
+			return nil
+		})
+	})
+}
+
+// GeneratingStatus resolves the key for cancelling in the given waiting status
+// text
+func (self *CommitGeneratorHelper) GeneratingStatus(statusText string) string {
+	return utils.ResolvePlaceholderString(statusText,
+		map[string]string{"key": keybindings.Label(self.c.UserConfig().Keybinding.Universal.Return)})
+}
+
+// GenerateFromStagedChanges runs the given generator on the staged changes,
+// applies the branch's commit prefix to the summary, as we do for messages
+// that the user types, and formats the result with FormatGeneratedMessage.
+// Must not be called on the UI thread.
+func (self *CommitGeneratorHelper) GenerateFromStagedChanges(
+	task *CommitGenerationTask,
+	generator config.CommitGeneratorCommand,
+	onOutput func(string),
+) (GeneratedCommitMessage, error) {
+	input, err := self.StagedChangesInput()
+	if err != nil {
+		return GeneratedCommitMessage{}, err
+	}
+
+	message, err := self.Generate(task, generator, input, onOutput)
+	if err != nil {
+		return GeneratedCommitMessage{}, err
+	}
+
+	prefix, err := self.commitsHelper.CommitPrefixForBranch(input.Branch)
+	if err != nil {
+		return GeneratedCommitMessage{}, err
+	}
+	message.Summary = ApplyCommitPrefix(message.Summary, prefix)
+
+	return self.FormatGeneratedMessage(message), nil
+}
+
+// FormatGeneratedMessage formats a generated message according to the user's
+// config (see FormatGeneratedCommitMessage), and warns the user if its
+// summary is longer than gui.commitLength.max.
+func (self *CommitGeneratorHelper) FormatGeneratedMessage(message GeneratedCommitMessage) GeneratedCommitMessage {
+	maxLength := self.c.UserConfig().Gui.CommitLength.Max
+	if length := utf8.RuneCountInString(message.Summary); maxLength > 0 && length > maxLength {
+		self.c.ErrorToast(utils.ResolvePlaceholderString(self.c.Tr.GeneratedSummaryTooLong,
+			map[string]string{"length": fmt.Sprint(length), "max": fmt.Sprint(maxLength)}))
+	}
+
+	return FormatGeneratedCommitMessage(message, self.c.UserConfig().Git.Commit)
+}
+
+// streamingOutput collects a generator's output and reports everything
+// received so far whenever more arrives.
+type streamingOutput struct {
+	buf      strings.Builder
+	onOutput func(string)
+}
+
+func (self *streamingOutput) Write(p []byte) (int, error) {
+	self.buf.Write(p)
+	self.onOutput(SanitizeGeneratedCommitMessage(self.buf.String()))
+	return len(p), nil
+}
+
+// IsGenerating returns true while a generation task is running.
+func (self *CommitGeneratorHelper) IsGenerating() bool {
+	return self.currentTask.Load() != nil
+}
+
+// CancelGeneration cancels the running generation task, if any. Its pending
+// Generate call then returns ErrCommitGenerationCancelled.
+func (self *CommitGeneratorHelper) CancelGeneration() {
+	if task := self.currentTask.Load(); task != nil {
+		task.Cancel()
+	}
+}
+
+func (self *CommitGeneratorHelper) generatorCmdObj(generator config.CommitGeneratorCommand, input CommitGeneratorInput) (*oscommands.CmdObj, error) {
+	cmdStr, err := self.resolveCommand(generator)
+	if err != nil {
+		return nil, err
+	}
+
+	stdin, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.c.OS().Cmd.NewShell(cmdStr, self.c.UserConfig().OS.ShellFunctionsFile).
+		SetWd(self.c.Git().RepoPaths.WorktreePath()).
+		SetStdin(string(stdin)).
+		DontLog(), nil
+}
+
+func (self *CommitGeneratorHelper) resolveCommand(generator config.CommitGeneratorCommand) (string, error) {
+	funcs := template.FuncMap{
+		"quote":      self.c.OS().Quote,
+		"runCommand": self.c.Git().Custom.TemplateFunctionRunCommand,
+	}
+
+	object := map[string]any{
+		"RepoPath":   self.c.Git().RepoPaths.RepoPath(),
+		"BranchName": self.c.Model().CheckedOutBranch,
+	}
+	if strings.Contains(generator.Command, ".ZeeMuxPath") {
+		zeemuxPath, err := resolveBundledZeeMuxPath()
+		if err != nil {
+			return "", err
+		}
+		object["ZeeMuxPath"] = zeemuxPath
+	}
+
+	return utils.ResolveTemplate(generator.Command, object, funcs)
+}
+
+// SanitizeGeneratedCommitMessage normalises line endings and surrounding
+// whitespace of a generator's output.
+func SanitizeGeneratedCommitMessage(message string) string {
+	clean := strings.ReplaceAll(message, "\r\n", "\n")
+	clean = strings.ReplaceAll(clean, "\r", "\n")
+	return strings.TrimSpace(clean)
+}
+
+// ParseGeneratedCommitMessage splits a generator's output into summary and
+// description. The output is either a JSON object with "summary" and
+// "description" fields, or plain text whose first line is the summary and
+// whose remaining lines are the description.
+func ParseGeneratedCommitMessage(output string) GeneratedCommitMessage {
+	clean := SanitizeGeneratedCommitMessage(output)
+
+	if strings.HasPrefix(clean, "{") {
+		var message GeneratedCommitMessage
+		if err := json.Unmarshal([]byte(clean), &message); err == nil {
+			return GeneratedCommitMessage{
+				Summary:     strings.TrimSpace(message.Summary),
+				Description: SanitizeGeneratedCommitMessage(message.Description),
+			}
+		}
+	}
+
+	summary, description, _ := strings.Cut(clean, "\n")
+	return GeneratedCommitMessage{
+		Summary:     strings.TrimSpace(summary),
+		Description: strings.TrimSpace(description),
+	}
+}
+
+// ApplyCommitPrefix puts the given prefix in front of the summary, unless the
+// summary already starts with it.
+func ApplyCommitPrefix(summary string, prefix string) string {
+	if prefix == "" || strings.HasPrefix(summary, prefix) ||
+		strings.HasPrefix(summary, strings.TrimSpace(prefix)) {
+		return summary
+	}
+
+	return prefix + summary
+}
+
+// FormatGeneratedCommitMessage makes a generated message look like one that
+// the user typed into the commit message panel: if
+// git.commit.autoWrapCommitMessage is on, the description is wrapped at
+// git.commit.autoWrapWidth. Line breaks that the generator inserted itself
+// where wrapping would put them anyway are removed first, so that wrapping
+// doesn't leave a short line after each of them.
+func FormatGeneratedCommitMessage(message GeneratedCommitMessage, commitConfig config.CommitConfig) GeneratedCommitMessage {
+	if commitConfig.AutoWrapCommitMessage {
+		description := TryRemoveHardLineBreaks(message.Description, commitConfig.AutoWrapWidth)
+		message.Description = HardWrapCommitDescription(description, commitConfig.AutoWrapWidth)
+	}
+	return message
+}
+
+// HardWrapCommitDescription breaks the lines of a description at the given
+// width, the same way the commit description panel wraps them when
+// git.commit.autoWrapCommitMessage is on.
+func HardWrapCommitDescription(description string, autoWrapWidth int) string {
+	var result strings.Builder
+	lineStart := 0
+	for _, softLineBreakIndex := range gocui.AutoWrapContent(description, autoWrapWidth) {
+		result.WriteString(strings.TrimRight(description[lineStart:softLineBreakIndex], " "))
+		result.WriteString("\n")
+		lineStart = softLineBreakIndex
+	}
+	result.WriteString(description[lineStart:])
+	return result.String()
+}
+
+// TrimDiffToBudget shrinks a diff so that it is at most maxBytes long (if
+// possible). It first leaves out the content of binary files, then that of
+// files matching one of lowPriorityPatterns (e.g. lockfiles), and finally
+// truncates the remaining files. Every file keeps its "diff --git" line, and
+// left-out content is replaced by a note, so that a generator still knows
+// which files were changed and that it is not seeing everything. A maxBytes of
+// 0 means no limit.
+func TrimDiffToBudget(diff string, maxBytes int, lowPriorityPatterns []string) string {
+	if maxBytes <= 0 || len(diff) <= maxBytes {
+		return diff
+	}
+
+	sections := splitDiffByFile(diff)
+	totalSize := func() int {
+		return lo.SumBy(sections, func(section string) int { return len(section) })
+	}
+
+	isLowPriority := func(section string) bool {
+		path := diffSectionPath(section)
+		return lo.SomeBy(lowPriorityPatterns, func(pattern string) bool {
+			matchesPath, _ := filepath.Match(pattern, path)
+			matchesName, _ := filepath.Match(pattern, filepath.Base(path))
+			return matchesPath || matchesName
+		})
+	}
+
+	for _, pass := range []struct {
+		shouldOmit func(string) bool
+		note       string
+	}{
+		{shouldOmit: isBinaryDiffSection, note: "(binary file, diff omitted)"},
+		{shouldOmit: isLowPriority, note: "(diff omitted to fit the size limit)"},
+	} {
+		for i, section := range sections {
+			if totalSize() <= maxBytes {
+				return strings.Join(sections, "")
+			}
+			if pass.shouldOmit(section) {
+				sections[i] = omitDiffSectionContent(section, pass.note)
+			}
+		}
+	}
+
+	if totalSize() <= maxBytes {
+		return strings.Join(sections, "")
+	}
+
+	// Still too big, so share the remaining budget between the files in order,
+	// always keeping the first line of each file.
+	truncatedNote := "... (truncated to fit the size limit)\n"
+	headerSize := lo.SumBy(sections, func(section string) int {
+		return len(diffSectionFirstLine(section)) + len(truncatedNote)
+	})
+	remainingBudget := max(maxBytes-headerSize, 0)
+	for i, section := range sections {
+		firstLine := diffSectionFirstLine(section)
+		content := section[len(firstLine):]
+		if len(content) <= remainingBudget {
+			remainingBudget -= len(content)
+			continue
+		}
+
+		cut := content[:remainingBudget]
+		if idx := strings.LastIndex(cut, "\n"); idx != -1 {
+			cut = cut[:idx+1]
+		} else {
+			cut = ""
+		}
+		remainingBudget -= len(cut)
+		sections[i] = firstLine + cut + truncatedNote
+	}
+
+	return strings.Join(sections, "")
+}
+
+// splitDiffByFile splits a diff into one section per file; each section starts
+// with its "diff --git" line and ends with a newline. Anything before the first
+// "diff --git" line is its own section.
+func splitDiffByFile(diff string) []string {
+	sections := []string{}
+	start := 0
+	for _, idx := range diffSectionStarts(diff) {
+		if idx > start {
+			sections = append(sections, diff[start:idx])
+		}
+		start = idx
+	}
+	sections = append(sections, diff[start:])
+	return lo.Filter(sections, func(section string, _ int) bool { return section != "" })
+}
+
+func diffSectionStarts(diff string) []int {
+	starts := []int{}
+	if strings.HasPrefix(diff, "diff --git ") {
+		starts = append(starts, 0)
+	}
+	offset := 0
+	for {
+		idx := strings.Index(diff[offset:], "\ndiff --git ")
+		if idx == -1 {
+			return starts
+		}
+		offset += idx + 1
+		starts = append(starts, offset)
+	}
+}
+
+func diffSectionFirstLine(section string) string {
+	if idx := strings.Index(section, "\n"); idx != -1 {
+		return section[:idx+1]
+	}
+	return section + "\n"
+}
+
+func diffSectionPath(section string) string {
+	firstLine := strings.TrimSuffix(diffSectionFirstLine(section), "\n")
+	if idx := strings.LastIndex(firstLine, " b/"); idx != -1 {
+		return firstLine[idx+len(" b/"):]
+	}
+	return ""
+}
+
+func isBinaryDiffSection(section string) bool {
+	return strings.Contains(section, "\nBinary files ") || strings.Contains(section, "\nGIT binary patch")
+}
+
+func omitDiffSectionContent(section string, note string) string {
+	return diffSectionFirstLine(section) + note + "\n"
+}
+
+func resolveBundledZeeMuxPath() (string, error) {
+	if exePath, err := os.Executable(); err == nil {
+		candidate := filepath.Join(filepath.Dir(exePath), "zeemux")
+		if isExecutableFile(candidate) {
+			return candidate, nil
+		}
+	}
+
+	if pathLookup, err := exec.LookPath("zeemux"); err == nil {
+		return pathLookup, nil
+	}
+
+	return "", errors.New("zeemux not found (expected `zeemux` next to lazygit or in PATH)")
+}
+
+func isExecutableFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if !info.Mode().IsRegular() {
+		return false
+	}
+	return info.Mode()&0o111 != 0
+}
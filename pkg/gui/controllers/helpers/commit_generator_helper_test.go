@@ -0,0 +1,256 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeGeneratedCommitMessage(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		message        string
+		expectedResult string
+	}{
+		{
+			name:           "empty",
+			message:        "",
+			expectedResult: "",
+		},
+		{
+			name:           "only whitespace",
+			message:        " \n\t\n",
+			expectedResult: "",
+		},
+		{
+			name:           "windows line endings",
+			message:        "Fix the thing\r\n\r\nIt was broken\r\n",
+			expectedResult: "Fix the thing\n\nIt was broken",
+		},
+		{
+			name:           "long messages are kept",
+			message:        strings.Repeat("a", 700),
+			expectedResult: strings.Repeat("a", 700),
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, SanitizeGeneratedCommitMessage(s.message))
+		})
+	}
+}
+
+func TestTrimDiffToBudget(t *testing.T) {
+	textDiff := func(path string, lines int) string {
+		return "diff --git a/" + path + " b/" + path + "\n" +
+			"--- a/" + path + "\n+++ b/" + path + "\n@@ -1 +1 @@\n" +
+			strings.Repeat("+line\n", lines)
+	}
+	binaryDiff := "diff --git a/image.png b/image.png\nindex 1234..5678 100644\nBinary files a/image.png and b/image.png differ\n"
+
+	scenarios := []struct {
+		name                string
+		diff                string
+		maxBytes            int
+		lowPriorityPatterns []string
+		expectedResult      string
+	}{
+		{
+			name:           "no limit",
+			diff:           textDiff("main.go", 100),
+			maxBytes:       0,
+			expectedResult: textDiff("main.go", 100),
+		},
+		{
+			name:           "within budget",
+			diff:           textDiff("main.go", 2) + binaryDiff,
+			maxBytes:       1000,
+			expectedResult: textDiff("main.go", 2) + binaryDiff,
+		},
+		{
+			name:           "binary files are omitted first",
+			diff:           binaryDiff + textDiff("main.go", 2),
+			maxBytes:       len(textDiff("main.go", 2)) + 80,
+			expectedResult: "diff --git a/image.png b/image.png\n(binary file, diff omitted)\n" + textDiff("main.go", 2),
+		},
+		{
+			name:                "low priority files are omitted next",
+			diff:                textDiff("go.sum", 50) + textDiff("main.go", 2) + textDiff("web/package-lock.json", 50),
+			maxBytes:            300,
+			lowPriorityPatterns: []string{"go.sum", "package-lock.json"},
+			expectedResult: "diff --git a/go.sum b/go.sum\n(diff omitted to fit the size limit)\n" +
+				textDiff("main.go", 2) +
+				"diff --git a/web/package-lock.json b/web/package-lock.json\n(diff omitted to fit the size limit)\n",
+		},
+		{
+			name:     "remaining files are truncated at line boundaries",
+			diff:     textDiff("a.go", 50) + textDiff("b.go", 50),
+			maxBytes: 200,
+			expectedResult: "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n" + strings.Repeat("+line\n", 6) +
+				"... (truncated to fit the size limit)\n" +
+				"diff --git a/b.go b/b.go\n... (truncated to fit the size limit)\n",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, TrimDiffToBudget(s.diff, s.maxBytes, s.lowPriorityPatterns))
+		})
+	}
+}
+
+func TestParseGeneratedCommitMessage(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		output         string
+		expectedResult GeneratedCommitMessage
+	}{
+		{
+			name:           "summary only",
+			output:         "Fix the thing\n",
+			expectedResult: GeneratedCommitMessage{Summary: "Fix the thing"},
+		},
+		{
+			name:   "summary and description",
+			output: "Fix the thing\n\nIt was broken.\n\nNow it isn't.\n",
+			expectedResult: GeneratedCommitMessage{
+				Summary:     "Fix the thing",
+				Description: "It was broken.\n\nNow it isn't.",
+			},
+		},
+		{
+			name:   "json",
+			output: `{"summary": " Fix the thing ", "description": "It was broken.\r\n"}`,
+			expectedResult: GeneratedCommitMessage{
+				Summary:     "Fix the thing",
+				Description: "It was broken.",
+			},
+		},
+		{
+			name:           "invalid json is treated as text",
+			output:         "{not json",
+			expectedResult: GeneratedCommitMessage{Summary: "{not json"},
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, ParseGeneratedCommitMessage(s.output))
+		})
+	}
+}
+
+func TestApplyCommitPrefix(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		summary        string
+		prefix         string
+		expectedResult string
+	}{
+		{
+			name:           "no prefix",
+			summary:        "Fix the thing",
+			prefix:         "",
+			expectedResult: "Fix the thing",
+		},
+		{
+			name:           "prefix is added",
+			summary:        "Fix the thing",
+			prefix:         "[ABC-123] ",
+			expectedResult: "[ABC-123] Fix the thing",
+		},
+		{
+			name:           "summary already has the prefix",
+			summary:        "[ABC-123] Fix the thing",
+			prefix:         "[ABC-123] ",
+			expectedResult: "[ABC-123] Fix the thing",
+		},
+		{
+			name:           "summary has the prefix without the trailing space",
+			summary:        "[ABC-123]: Fix the thing",
+			prefix:         "[ABC-123] ",
+			expectedResult: "[ABC-123]: Fix the thing",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, ApplyCommitPrefix(s.summary, s.prefix))
+		})
+	}
+}
+
+func TestHardWrapCommitDescription(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		description    string
+		autoWrapWidth  int
+		expectedResult string
+	}{
+		{
+			name:           "short lines",
+			description:    "abc\ndef",
+			autoWrapWidth:  10,
+			expectedResult: "abc\ndef",
+		},
+		{
+			name:           "long line",
+			description:    "abc def ghi jkl mno",
+			autoWrapWidth:  8,
+			expectedResult: "abc def\nghi jkl\nmno",
+		},
+		{
+			name:           "paragraphs are kept",
+			description:    "abc def ghi\n\njkl",
+			autoWrapWidth:  8,
+			expectedResult: "abc def\nghi\n\njkl",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedResult, HardWrapCommitDescription(s.description, s.autoWrapWidth))
+		})
+	}
+}
+
+func TestFormatGeneratedCommitMessage(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		description    string
+		autoWrap       bool
+		expectedResult string
+	}{
+		{
+			name:           "auto-wrap off",
+			description:    "abc def ghi jkl mno",
+			autoWrap:       false,
+			expectedResult: "abc def ghi jkl mno",
+		},
+		{
+			name:           "long line",
+			description:    "abc def ghi jkl mno",
+			autoWrap:       true,
+			expectedResult: "abc def\nghi jkl\nmno",
+		},
+		{
+			name:           "generator wrapped at the same width",
+			description:    "abc def\nghi jkl\nmno",
+			autoWrap:       true,
+			expectedResult: "abc def\nghi jkl\nmno",
+		},
+		{
+			name:           "generator wrapped at a wider width",
+			description:    "abc def ghi\njkl mno",
+			autoWrap:       true,
+			expectedResult: "abc def\nghi\njkl mno",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			commitConfig := config.CommitConfig{AutoWrapCommitMessage: s.autoWrap, AutoWrapWidth: 8}
+			message := GeneratedCommitMessage{Summary: "summary", Description: s.description}
+			assert.Equal(t, GeneratedCommitMessage{Summary: "summary", Description: s.expectedResult},
+				FormatGeneratedCommitMessage(message, commitConfig))
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+var (
+	conventionalSummaryRegexp = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()]*)\))?!?: \S`)
+	commitTrailerRegexp       = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*):\s*(.*)$`)
+)
+
+// Words that look like they aren't in the imperative mood, but are
+var imperativeMoodExceptions = []string{
+	"bleed", "breed", "embed", "exceed", "feed", "need", "proceed", "seed", "shed", "speed", "succeed",
+	"bring", "ping", "ring", "sing", "string", "swing",
+	"alias", "bias", "canvas", "focus", "process", "redis", "status",
+}
+
+type CommitLintHelper struct {
+	c *HelperCommon
+}
+
+func NewCommitLintHelper(c *HelperCommon) *CommitLintHelper {
+	return &CommitLintHelper{
+		c: c,
+	}
+}
+
+// Violations returns the lint rules that the given message breaks.
+func (self *CommitLintHelper) Violations(summary string, description string) []string {
+	return LintCommitMessage(self.c.Tr, self.c.UserConfig().Git.Commit.Lint, summary, description)
+}
+
+// ViolationsSubtitle returns a short description of the lint rules that the
+// given message breaks, suitable for a view's subtitle, or an empty string if
+// it breaks none.
+func (self *CommitLintHelper) ViolationsSubtitle(summary string, description string) string {
+	violations := self.Violations(summary, description)
+	switch len(violations) {
+	case 0:
+		return ""
+	case 1:
+		return "✗ " + violations[0]
+	default:
+		return utils.ResolvePlaceholderString(self.c.Tr.CommitLintViolationsSubtitle, map[string]string{
+			"first": violations[0],
+			"more":  fmt.Sprint(len(violations) - 1),
+		})
+	}
+}
+
+// RenderCommitInputViolations shows the lint rules that the message in the
+// commit input breaks in its subtitle.
+func (self *CommitLintHelper) RenderCommitInputViolations() {
+	view := self.c.Views().CommitInput
+	summary, description, _ := strings.Cut(strings.TrimSpace(view.TextArea.GetUnwrappedContent()), "\n")
+	subtitle := self.ViolationsSubtitle(strings.TrimSpace(summary), strings.TrimSpace(description))
+	if subtitle != "" {
+		subtitle = " " + subtitle + " "
+	}
+	view.Subtitle = subtitle
+}
+
+// WithLintCheck calls f right away if the message doesn't break any lint
+// rules, and otherwise only after the user confirms that they want to commit
+// it anyway.
+func (self *CommitLintHelper) WithLintCheck(summary string, description string, f func() error) error {
+	violations := self.Violations(summary, description)
+	return self.c.ConfirmIf(len(violations) > 0, types.ConfirmOpts{
+		Title: self.c.Tr.CommitLintViolationsTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.CommitLintViolationsPrompt, map[string]string{
+			"violations": strings.Join(lo.Map(violations, func(violation string, _ int) string {
+				return "- " + violation
+			}), "\n"),
+		}),
+		HandleConfirm: f,
+	})
+}
+
+// LintCommitMessage returns the rules of lintConfig that the given message
+// breaks. An empty summary breaks no rules; not having a message at all is
+// reported elsewhere.
+func LintCommitMessage(tr *i18n.TranslationSet, lintConfig config.CommitLintConfig, summary string, description string) []string {
+	if summary == "" || !lintConfig.IsEnabled() {
+		return nil
+	}
+
+	violations := []string{}
+	subject := summary
+
+	if len(lintConfig.Types) > 0 || len(lintConfig.Scopes) > 0 {
+		if match := conventionalSummaryRegexp.FindStringSubmatch(summary); match == nil {
+			violations = append(violations, tr.CommitLintNotConventional)
+		} else {
+			commitType, scope := match[1], match[2]
+			if len(lintConfig.Types) > 0 && !slices.Contains(lintConfig.Types, commitType) {
+				violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintUnknownType,
+					map[string]string{"type": commitType}))
+			}
+			if len(lintConfig.Scopes) > 0 && scope != "" && !slices.Contains(lintConfig.Scopes, scope) {
+				violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintUnknownScope,
+					map[string]string{"scope": scope}))
+			}
+			_, subject, _ = strings.Cut(summary, ": ")
+		}
+	}
+
+	if lintConfig.MaxSummaryLength > 0 {
+		if length := utf8.RuneCountInString(summary); length > lintConfig.MaxSummaryLength {
+			violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintSummaryTooLong,
+				map[string]string{"length": fmt.Sprint(length), "max": fmt.Sprint(lintConfig.MaxSummaryLength)}))
+		}
+	}
+
+	if lintConfig.ImperativeMood {
+		if word := firstWord(subject); word != "" && !looksImperative(word) {
+			violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintNotImperative,
+				map[string]string{"word": word}))
+		}
+	}
+
+	trailers := commitTrailers(description)
+	for _, required := range lintConfig.RequiredTrailers {
+		values := lo.FilterMap(trailers, func(trailer [2]string, _ int) (string, bool) {
+			return trailer[1], strings.EqualFold(trailer[0], required.Key)
+		})
+		if len(values) == 0 {
+			violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintMissingTrailer,
+				map[string]string{"key": required.Key}))
+			continue
+		}
+
+		if required.ValuePattern == "" {
+			continue
+		}
+		// An invalid pattern is reported when loading the config
+		pattern, err := regexp.Compile(required.ValuePattern)
+		if err != nil {
+			continue
+		}
+		if !lo.SomeBy(values, pattern.MatchString) {
+			violations = append(violations, utils.ResolvePlaceholderString(tr.CommitLintTrailerMismatch,
+				map[string]string{"key": required.Key, "pattern": required.ValuePattern}))
+		}
+	}
+
+	return violations
+}
+
+// Returns the first word of the subject that starts with a letter, lowercased,
+// so that e.g. ticket IDs in front of it are skipped
+func firstWord(subject string) string {
+	for _, field := range strings.Fields(subject) {
+		first, _ := utf8.DecodeRuneInString(field)
+		if unicode.IsLetter(first) {
+			return strings.ToLower(strings.TrimRightFunc(field, func(r rune) bool { return !unicode.IsLetter(r) }))
+		}
+	}
+
+	return ""
+}
+
+// A rough heuristic: past tense ('fixed'), progressive ('fixing') and third
+// person ('fixes') forms are not imperative.
+func looksImperative(word string) bool {
+	if slices.Contains(imperativeMoodExceptions, word) {
+		return true
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ed") && len(word) >= 5:
+		return false
+	case strings.HasSuffix(word, "ing") && len(word) >= 6:
+		return false
+	case strings.HasSuffix(word, "s") && len(word) >= 4 &&
+		!strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		return false
+	}
+
+	return true
+}
+
+// Returns the key/value pairs of the trailers in the last paragraph of the
+// description
+func commitTrailers(description string) [][2]string {
+	paragraphs := strings.Split(strings.TrimSpace(description), "\n\n")
+	lastParagraph := paragraphs[len(paragraphs)-1]
+	return lo.FilterMap(strings.Split(lastParagraph, "\n"), func(line string, _ int) ([2]string, bool) {
+		match := commitTrailerRegexp.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			return [2]string{}, false
+		}
+		return [2]string{match[1], strings.TrimSpace(match[2])}, true
+	})
+}
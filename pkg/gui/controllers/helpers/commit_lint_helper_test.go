@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCommitMessage(t *testing.T) {
+	scenarios := []struct {
+		name               string
+		lintConfig         config.CommitLintConfig
+		summary            string
+		description        string
+		expectedViolations []string
+	}{
+		{
+			name:               "no rules",
+			lintConfig:         config.CommitLintConfig{},
+			summary:            "whatever I want",
+			expectedViolations: nil,
+		},
+		{
+			name:               "empty summary",
+			lintConfig:         config.CommitLintConfig{Types: []string{"feat"}, MaxSummaryLength: 5},
+			summary:            "",
+			expectedViolations: nil,
+		},
+		{
+			name:               "conventional summary with allowed type and scope",
+			lintConfig:         config.CommitLintConfig{Types: []string{"feat", "fix"}, Scopes: []string{"gui"}},
+			summary:            "fix(gui)!: Handle resizing",
+			expectedViolations: []string{},
+		},
+		{
+			name:               "not conventional",
+			lintConfig:         config.CommitLintConfig{Types: []string{"feat", "fix"}},
+			summary:            "Handle resizing",
+			expectedViolations: []string{"summary should look like 'type: subject' or 'type(scope): subject'"},
+		},
+		{
+			name:               "unknown type and scope",
+			lintConfig:         config.CommitLintConfig{Types: []string{"feat", "fix"}, Scopes: []string{"gui"}},
+			summary:            "wip(git): Handle resizing",
+			expectedViolations: []string{"unknown type 'wip'", "unknown scope 'git'"},
+		},
+		{
+			name:               "summary too long",
+			lintConfig:         config.CommitLintConfig{MaxSummaryLength: 10},
+			summary:            "Handle resizing",
+			expectedViolations: []string{"summary is 15 characters long (max 10)"},
+		},
+		{
+			name:               "imperative mood",
+			lintConfig:         config.CommitLintConfig{ImperativeMood: true},
+			summary:            "Add option",
+			expectedViolations: []string{},
+		},
+		{
+			name:               "past tense",
+			lintConfig:         config.CommitLintConfig{ImperativeMood: true},
+			summary:            "[ABC-123] Added option",
+			expectedViolations: []string{"use the imperative mood ('added')"},
+		},
+		{
+			name:               "third person after conventional type",
+			lintConfig:         config.CommitLintConfig{Types: []string{"feat"}, ImperativeMood: true},
+			summary:            "feat: adds option",
+			expectedViolations: []string{"use the imperative mood ('adds')"},
+		},
+		{
+			name:               "exceptions to the imperative mood heuristic",
+			lintConfig:         config.CommitLintConfig{ImperativeMood: true},
+			summary:            "Embed status",
+			expectedViolations: []string{},
+		},
+		{
+			name: "required trailers present",
+			lintConfig: config.CommitLintConfig{RequiredTrailers: []config.CommitLintTrailer{
+				{Key: "Signed-off-by"},
+				{Key: "Refs", ValuePattern: `^[A-Z]+-\d+$`},
+			}},
+			summary:            "Add option",
+			description:        "Some text.\n\nrefs: ABC-123\nSigned-off-by: Jane <jane@example.com>",
+			expectedViolations: []string{},
+		},
+		{
+			name: "required trailers missing or not matching",
+			lintConfig: config.CommitLintConfig{RequiredTrailers: []config.CommitLintTrailer{
+				{Key: "Signed-off-by"},
+				{Key: "Refs", ValuePattern: `^[A-Z]+-\d+$`},
+			}},
+			summary:     "Add option",
+			description: "Signed-off-by: Jane <jane@example.com>\n\nRefs: #123",
+			expectedViolations: []string{
+				"missing 'Signed-off-by' trailer",
+				"'Refs' trailer doesn't match '^[A-Z]+-\\d+$'",
+			},
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			assert.Equal(t, s.expectedViolations, LintCommitMessage(tr, s.lintConfig, s.summary, s.description))
+		})
+	}
+}
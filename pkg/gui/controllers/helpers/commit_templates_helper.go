@@ -0,0 +1,191 @@
+package helpers
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The values that the placeholders of a commit message template are filled in
+// with
+type CommitTemplateData struct {
+	BranchName  string
+	TicketID    string
+	StagedFiles []string
+}
+
+type CommitTemplatesHelper struct {
+	c *HelperCommon
+}
+
+func NewCommitTemplatesHelper(c *HelperCommon) *CommitTemplatesHelper {
+	return &CommitTemplatesHelper{
+		c: c,
+	}
+}
+
+// OpenTemplatesMenu shows a menu of the template file configured in git's
+// commit.template and the templates from the git.commit.templates config. The
+// chosen template, with its placeholders filled in, is passed to onSelect.
+func (self *CommitTemplatesHelper) OpenTemplatesMenu(onSelect func(message string) error) error {
+	menuItems := []*types.MenuItem{}
+
+	if path := self.c.Git().Config.GetCommitTemplate(); path != "" {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   self.c.Tr.GitCommitTemplate,
+			Tooltip: path,
+			OnPress: func() error {
+				message, err := self.readGitCommitTemplate(path)
+				if err != nil {
+					return err
+				}
+				return onSelect(message)
+			},
+		})
+	}
+
+	for _, commitTemplate := range self.c.UserConfig().Git.Commit.Templates {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   commitTemplate.Name,
+			Tooltip: commitTemplate.Message,
+			OnPress: func() error {
+				message, err := self.resolveTemplate(commitTemplate)
+				if err != nil {
+					return err
+				}
+				return onSelect(message)
+			},
+		})
+	}
+
+	if len(menuItems) == 0 {
+		return errors.New(self.c.Tr.NoCommitTemplates)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommitTemplatesMenuTitle,
+		Items: menuItems,
+	})
+}
+
+// OpenHistoryMenu shows a menu of the most recent commit messages from all
+// branches, which can be searched like any other menu. The chosen message is
+// passed to onSelect.
+func (self *CommitTemplatesHelper) OpenHistoryMenu(onSelect func(message string) error) error {
+	messages, err := self.c.Git().Commit.GetRecentCommitMessages(self.c.UserConfig().Git.Commit.HistoryPickerSize)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return errors.New(self.c.Tr.NoCommitMessagesInHistory)
+	}
+
+	menuItems := lo.Map(messages, func(message string, _ int) *types.MenuItem {
+		summary, _, _ := strings.Cut(message, "\n")
+		return &types.MenuItem{
+			Label:   summary,
+			Tooltip: message,
+			OnPress: func() error { return onSelect(message) },
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommitMessageHistoryTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *CommitTemplatesHelper) resolveTemplate(commitTemplate config.CommitTemplate) (string, error) {
+	branchName := self.c.Model().CheckedOutBranch
+	ticketID, err := TicketIDFromBranchName(branchName, self.c.UserConfig().Git.Commit.TicketIDPattern)
+	if err != nil {
+		return "", err
+	}
+
+	stagedFiles := lo.FilterMap(self.c.Model().Files, func(file *models.File, _ int) (string, bool) {
+		return file.Path, file.HasStagedChanges
+	})
+
+	return ResolveCommitTemplate(commitTemplate.Message, CommitTemplateData{
+		BranchName:  branchName,
+		TicketID:    ticketID,
+		StagedFiles: stagedFiles,
+	})
+}
+
+// Git's templates have no placeholders, but may contain comment lines that git
+// would strip from the final message
+func (self *CommitTemplatesHelper) readGitCommitTemplate(path string) (string, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(homeDir, rest)
+	} else if !filepath.IsAbs(path) {
+		path = filepath.Join(self.c.Git().RepoPaths.WorktreePath(), path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return StripCommentLines(string(content), self.c.Git().Config.GetCoreCommentChar()), nil
+}
+
+// ResolveCommitTemplate fills in the placeholders of a commit message template
+func ResolveCommitTemplate(message string, data CommitTemplateData) (string, error) {
+	funcs := template.FuncMap{
+		"join": strings.Join,
+	}
+
+	resolved, err := utils.ResolveTemplate(message, data, funcs)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(resolved), nil
+}
+
+// TicketIDFromBranchName returns the part of the branch name that the pattern
+// matches, or its first capture group if it has one. Returns an empty string
+// if the pattern is empty or doesn't match.
+func TicketIDFromBranchName(branchName string, pattern string) (string, error) {
+	if pattern == "" {
+		return "", nil
+	}
+
+	rgx, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	match := rgx.FindStringSubmatch(branchName)
+	if match == nil {
+		return "", nil
+	}
+	if len(match) > 1 {
+		return match[1], nil
+	}
+	return match[0], nil
+}
+
+// StripCommentLines removes the lines starting with the given comment
+// character, and surrounding whitespace
+func StripCommentLines(message string, commentChar byte) string {
+	lines := lo.Filter(strings.Split(message, "\n"), func(line string, _ int) bool {
+		return !strings.HasPrefix(line, string(commentChar))
+	})
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
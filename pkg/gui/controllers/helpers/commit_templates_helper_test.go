@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCommitTemplate(t *testing.T) {
+	data := CommitTemplateData{
+		BranchName:  "feature/ABC-123-resize",
+		TicketID:    "ABC-123",
+		StagedFiles: []string{"pkg/gui/layout.go", "pkg/gui/view.go"},
+	}
+
+	scenarios := []struct {
+		name            string
+		message         string
+		expectedMessage string
+		expectedErr     string
+	}{
+		{
+			name:            "no placeholders",
+			message:         "Fix typo\n",
+			expectedMessage: "Fix typo",
+		},
+		{
+			name:            "ticket ID and branch name",
+			message:         "[{{.TicketID}}] \n\nFrom {{.BranchName}}",
+			expectedMessage: "[ABC-123] \n\nFrom feature/ABC-123-resize",
+		},
+		{
+			name:            "staged files",
+			message:         "Update {{join .StagedFiles \", \"}}\n\n{{range .StagedFiles}}- {{.}}\n{{end}}",
+			expectedMessage: "Update pkg/gui/layout.go, pkg/gui/view.go\n\n- pkg/gui/layout.go\n- pkg/gui/view.go",
+		},
+		{
+			name:        "unknown placeholder",
+			message:     "{{.Ticket}}",
+			expectedErr: "can't evaluate field Ticket",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			message, err := ResolveCommitTemplate(s.message, data)
+			if s.expectedErr != "" {
+				assert.ErrorContains(t, err, s.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedMessage, message)
+		})
+	}
+}
+
+func TestTicketIDFromBranchName(t *testing.T) {
+	scenarios := []struct {
+		name             string
+		branchName       string
+		pattern          string
+		expectedTicketID string
+	}{
+		{
+			name:             "whole match",
+			branchName:       "feature/ABC-123-resize",
+			pattern:          `[A-Z][A-Z0-9]+-\d+`,
+			expectedTicketID: "ABC-123",
+		},
+		{
+			name:             "capture group",
+			branchName:       "fix/42-crash",
+			pattern:          `^\w+/(\d+)-`,
+			expectedTicketID: "42",
+		},
+		{
+			name:             "no match",
+			branchName:       "main",
+			pattern:          `[A-Z][A-Z0-9]+-\d+`,
+			expectedTicketID: "",
+		},
+		{
+			name:             "no pattern",
+			branchName:       "feature/ABC-123-resize",
+			pattern:          "",
+			expectedTicketID: "",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ticketID, err := TicketIDFromBranchName(s.branchName, s.pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedTicketID, ticketID)
+		})
+	}
+}
+
+func TestStripCommentLines(t *testing.T) {
+	message := "\n# Summary in the imperative mood\n\nWhy:\n# Explain the motivation\n"
+	assert.Equal(t, "Why:", StripCommentLines(message, '#'))
+	assert.Equal(t, "# Summary in the imperative mood\n\nWhy:\n# Explain the motivation", StripCommentLines(message+"; Ignored", ';'))
+}
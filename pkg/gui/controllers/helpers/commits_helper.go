@@ -2,18 +2,22 @@ package helpers
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
 
 type CommitsHelper struct {
-	c *HelperCommon
+	c              *HelperCommon
+	trailersHelper *TrailersHelper
 
 	getCommitSummary              func() string
 	setCommitSummary              func(string)
@@ -24,6 +28,7 @@ type CommitsHelper struct {
 
 func NewCommitsHelper(
 	c *HelperCommon,
+	trailersHelper *TrailersHelper,
 	getCommitSummary func() string,
 	setCommitSummary func(string),
 	getCommitDescription func() string,
@@ -32,6 +37,7 @@ func NewCommitsHelper(
 ) *CommitsHelper {
 	return &CommitsHelper{
 		c:                             c,
+		trailersHelper:                trailersHelper,
 		getCommitSummary:              getCommitSummary,
 		setCommitSummary:              setCommitSummary,
 		getCommitDescription:          getCommitDescription,
@@ -119,7 +125,15 @@ type OpenCommitMessagePanelOpts struct {
 	PreserveMessage  bool
 	OnConfirm        func(summary string, description string) error
 	OnSwitchToEditor func(string) error
-	InitialMessage   string
+	// Invoked when choosing to generate a message from the commit menu; leave
+	// unassigned if generating a message doesn't make sense for what you are
+	// doing
+	OnGenerate func() error
+	// Returns a short description of the lint rules that the message breaks,
+	// for the panel's subtitle; leave unassigned if the message shouldn't be
+	// linted
+	LintMessage    func(summary string, description string) string
+	InitialMessage string
 
 	// The following two fields are only for the display of the "(hooks
 	// disabled)" display in the commit message panel. They have no effect on
@@ -145,6 +159,8 @@ func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOp
 		opts.InitialMessage,
 		onConfirm,
 		opts.OnSwitchToEditor,
+		opts.OnGenerate,
+		opts.LintMessage,
 		opts.ForceSkipHooks,
 		opts.SkipHooksPrefix,
 	)
@@ -154,6 +170,37 @@ func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOp
 	self.c.Context().Push(self.c.Contexts().CommitMessage, types.OnFocusOpts{})
 }
 
+// CommitPrefixForBranch returns the prefix that the git.commitPrefix and
+// git.commitPrefixes configs derive from the given branch name, or an empty
+// string if none of them match.
+func (self *CommitsHelper) CommitPrefixForBranch(branchName string) (string, error) {
+	for _, commitPrefixConfig := range self.commitPrefixConfigsForRepo() {
+		prefixPattern := commitPrefixConfig.Pattern
+		if prefixPattern == "" {
+			continue
+		}
+		rgx, err := regexp.Compile(prefixPattern)
+		if err != nil {
+			return "", fmt.Errorf("%s: %s", self.c.Tr.CommitPrefixPatternError, err.Error())
+		}
+
+		if rgx.MatchString(branchName) {
+			return rgx.ReplaceAllString(branchName, commitPrefixConfig.Replace), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (self *CommitsHelper) commitPrefixConfigsForRepo() []config.CommitPrefixConfig {
+	cfg, ok := self.c.UserConfig().Git.CommitPrefixes[self.c.Git().RepoPaths.RepoName()]
+	if ok {
+		return append(cfg, self.c.UserConfig().Git.CommitPrefix...)
+	}
+
+	return self.c.UserConfig().Git.CommitPrefix
+}
+
 func (self *CommitsHelper) ClearPreservedCommitMessage() {
 	self.c.Contexts().CommitMessage.SetPreservedMessageAndLogError("")
 }
@@ -199,6 +246,13 @@ func (self *CommitsHelper) OpenCommitMenu(suggestionFunc func(string) []*types.S
 		}
 	}
 
+	var disabledReasonForGenerate *types.DisabledReason
+	if !self.c.Contexts().CommitMessage.CanGenerate() {
+		disabledReasonForGenerate = &types.DisabledReason{
+			Text: self.c.Tr.CommandDoesNotSupportGeneratingMessage,
+		}
+	}
+
 	menuItems := []*types.MenuItem{
 		{
 			Label: self.c.Tr.OpenInEditor,
@@ -215,6 +269,14 @@ func (self *CommitsHelper) OpenCommitMenu(suggestionFunc func(string) []*types.S
 			},
 			Key: 'c',
 		},
+		{
+			Label: self.c.Tr.EditTrailers,
+			OnPress: func() error {
+				return self.editTrailers()
+			},
+			Key:       't',
+			OpensMenu: true,
+		},
 		{
 			Label: self.c.Tr.PasteCommitMessageFromClipboard,
 			OnPress: func() error {
@@ -222,6 +284,14 @@ func (self *CommitsHelper) OpenCommitMenu(suggestionFunc func(string) []*types.S
 			},
 			Key: 'p',
 		},
+		{
+			Label: self.c.Tr.GenerateCommitMessage,
+			OnPress: func() error {
+				return self.c.Contexts().CommitMessage.Generate()
+			},
+			Key:            'g',
+			DisabledReason: disabledReasonForGenerate,
+		},
 	}
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.CommitMenuTitle,
@@ -244,6 +314,28 @@ func (self *CommitsHelper) addCoAuthor(suggestionFunc func(string) []*types.Sugg
 	return nil
 }
 
+func (self *CommitsHelper) editTrailers() error {
+	// interpret-trailers never takes the first paragraph of a message to be
+	// trailers, so we put a placeholder summary in front of the description
+	message := "summary\n\n" + self.getUnwrappedCommitDescription()
+	trailers, err := self.c.Git().Commit.GetTrailers(message)
+	if err != nil {
+		return err
+	}
+
+	return self.trailersHelper.OpenTrailersMenu(trailers, func(edit TrailersEdit) error {
+		newMessage, err := self.c.Git().Commit.SetTrailers(message, edit(trailers))
+		if err != nil {
+			return err
+		}
+
+		_, description, _ := strings.Cut(newMessage, "\n")
+		self.setCommitDescription(strings.TrimSpace(description))
+		self.c.Contexts().CommitMessage.RenderSubtitle()
+		return nil
+	})
+}
+
 func (self *CommitsHelper) pasteCommitMessageFromClipboard() error {
 	message, err := self.c.OS().PasteFromClipboard()
 	if err != nil {
@@ -41,7 +41,7 @@ func (self *DiffHelper) DiffArgs() []string {
 	if file != "" {
 		output = append(output, file)
 	} else if self.c.Modes().Filtering.Active() {
-		output = append(output, self.c.Modes().Filtering.GetPath())
+		output = append(output, self.filterPath())
 	}
 
 	return output
@@ -57,7 +57,7 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 		from, to := refRange.From, refRange.To
 		args := []string{from.ParentRefName(), to.RefName(), "--stat", "-p"}
 		args = append(args, "--")
-		if filterPath := self.c.Modes().Filtering.GetPath(); filterPath != "" {
+		if filterPath := self.filterPath(); filterPath != "" {
 			// If both refs are commits, filter by the union of their paths. This is useful for
 			// example when diffing a range of commits in filter-by-path mode across a rename.
 			fromCommit, ok1 := from.(*models.Commit)
@@ -77,12 +77,54 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 		return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
 	}
 
+	if lineRange := self.c.Modes().Filtering.GetLineRangeArg(); lineRange != "" {
+		if refName, skip, ok := self.lineRangeLogPosition(commit); ok {
+			cmdObj := self.c.Git().Commit.ShowLineRangeCmdObj(lineRange, refName, skip)
+			return types.NewRunPtyTask(cmdObj.GetCmd())
+		}
+	}
+
 	cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit))
+	if self.c.UserConfig().Gui.ShowCommitSignatures && commit.Signature != nil && commit.Signature.IsSigned() &&
+		self.c.Git().Commit.IsMissingAllowedSignersFile() {
+		prefix := style.FgYellow.Sprintf("%s\n\n", self.c.Tr.AllowedSignersFileMissing)
+		return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
+	}
 	return types.NewRunPtyTask(cmdObj.GetCmd())
 }
 
+// In filter-by-line-range mode, git log -L can only show the part of a commit's
+// diff that touches the followed lines when it walks the history from the same
+// ref as when we loaded the commits, so we return that ref and the number of
+// filtered commits that precede the given one.
+func (self *DiffHelper) lineRangeLogPosition(commit *models.Commit) (string, int, bool) {
+	if idx := lo.IndexOf(self.c.Model().Commits, commit); idx != -1 {
+		if self.c.Model().BisectInfo.Started() {
+			return "", 0, false
+		}
+		return lo.Ternary(self.c.Modes().Filtering.GetLineRangeRevision() != "",
+			self.c.Modes().Filtering.GetLineRangeRevision(), "HEAD"), idx, true
+	}
+
+	subCommitsContext := self.c.Contexts().SubCommits
+	if idx := lo.IndexOf(self.c.Model().SubCommits, commit); idx != -1 &&
+		subCommitsContext.GetRef() != nil && subCommitsContext.GetRefToShowDivergenceFrom() == "" {
+		return subCommitsContext.GetRef().FullRefName(), idx, true
+	}
+
+	return "", 0, false
+}
+
+// The path that the commits are filtered by, if any
+func (self *DiffHelper) filterPath() string {
+	if lineRangePath := self.c.Modes().Filtering.GetLineRangePath(); lineRangePath != "" {
+		return lineRangePath
+	}
+	return self.c.Modes().Filtering.GetPath()
+}
+
 func (self *DiffHelper) FilterPathsForCommit(commit *models.Commit) []string {
-	filterPath := self.c.Modes().Filtering.GetPath()
+	filterPath := self.filterPath()
 	if filterPath != "" {
 		if len(commit.FilterPaths) > 0 {
 			return commit.FilterPaths
@@ -3,11 +3,16 @@ package helpers
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -15,14 +20,17 @@ import (
 )
 
 type FixupHelper struct {
-	c *HelperCommon
+	c              *HelperCommon
+	mergeAndRebase *MergeAndRebaseHelper
 }
 
 func NewFixupHelper(
 	c *HelperCommon,
+	mergeAndRebase *MergeAndRebaseHelper,
 ) *FixupHelper {
 	return &FixupHelper{
-		c: c,
+		c:              c,
+		mergeAndRebase: mergeAndRebase,
 	}
 }
 
@@ -73,6 +81,315 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 		return errors.New(self.c.Tr.NoBaseCommitsFound)
 	}
 
+	foundCommits, err := self.findBaseCommits(commits, hashes)
+	if err != nil {
+		return err
+	}
+
+	if len(foundCommits) > 1 {
+		// If there are still multiple commits that could be the base commit, list
+		// them in the error message. But only the candidates from the current
+		// branch, not including any that are already merged.
+		subjects := getHashesAndSubjects(foundCommits)
+		message := lo.Ternary(hasStagedChanges,
+			self.c.Tr.MultipleBaseCommitsFoundStaged,
+			self.c.Tr.MultipleBaseCommitsFoundUnstaged)
+		return fmt.Errorf("%s\n\n%s", message, subjects)
+	}
+
+	// Now we know that foundCommits has exactly one commit, so find its index
+	_, index, _ := self.findCommit(commits, foundCommits[0].Hash())
+
+	return self.c.ConfirmIf(warnAboutAddedLines, types.ConfirmOpts{
+		Title:  self.c.Tr.FindBaseCommitForFixup,
+		Prompt: self.c.Tr.HunksWithOnlyAddedLinesWarning,
+		HandleConfirm: func() error {
+			if !hasStagedChanges {
+				if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
+					return err
+				}
+				self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+			}
+
+			self.c.Contexts().LocalCommits.SetSelection(index)
+			self.c.Contexts().LocalCommits.FocusLine(true)
+			self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
+			return nil
+		},
+	})
+}
+
+// absorbHunk is a hunk of the staged changes, together with the commit of the
+// current branch that it belongs to. If there is no single such commit, target
+// is nil and unassignedReason says why.
+type absorbHunk struct {
+	// index of the file's patch, and of the hunk within it
+	patchIdx int
+	hunkIdx  int
+	// the path of the file, and the line in the new file where the hunk starts
+	// (zero for files whose changes can't be split into hunks; patchIdx is -1
+	// for these)
+	path       string
+	lineNumber int
+
+	target           *models.Commit
+	unassignedReason string
+	committed        bool
+}
+
+// HandleAbsorbStagedChangesPress splits the staged changes into hunks, finds
+// the base commit for each hunk in the same way as
+// HandleFindBaseCommitForFixupPress does for all changes together, and offers
+// to create a fixup commit for each base commit.
+func (self *FixupHelper) HandleAbsorbStagedChangesPress() error {
+	if self.c.Git().Status.WorkingTreeState().Any() {
+		return errors.New(self.c.Tr.AlreadyRebasing)
+	}
+
+	diff, err := self.c.Git().Diff.DiffIndexCmdObj(
+		"--cached", "-U0", "--ignore-submodules=all", "HEAD", "--").RunWithOutput()
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		return errors.New(self.c.Tr.NoStagedChangesToAbsorb)
+	}
+
+	fileDiffs := []string{}
+	patches := []*patch.Patch{}
+	hunks := []*absorbHunk{}
+	unsplittableFiles := []*absorbHunk{}
+	for _, fileDiff := range splitDiffByFile(diff) {
+		filePatch := patch.Parse(fileDiff)
+		if filePatch.HunkCount() == 0 || strings.Contains(fileDiff, "\nold mode ") {
+			// Binary files and file mode changes simply stay staged
+			unsplittableFiles = append(unsplittableFiles, &absorbHunk{
+				patchIdx:         -1,
+				path:             diffSectionPath(fileDiff),
+				unassignedReason: self.c.Tr.AbsorbFileWithoutHunks,
+			})
+			continue
+		}
+
+		for i := range filePatch.HunkCount() {
+			hunks = append(hunks, &absorbHunk{
+				patchIdx:   len(patches),
+				hunkIdx:    i,
+				path:       diffSectionPath(fileDiff),
+				lineNumber: max(filePatch.LineNumberOfLine(filePatch.HunkStartIdx(i)), 1),
+			})
+		}
+		fileDiffs = append(fileDiffs, fileDiff)
+		patches = append(patches, filePatch)
+	}
+
+	if err := self.findBaseCommitsForHunks(fileDiffs, patches, hunks); err != nil {
+		return err
+	}
+	hunks = append(hunks, unsplittableFiles...)
+
+	table := self.formatAbsorbTable(hunks)
+	if !lo.SomeBy(hunks, func(h *absorbHunk) bool { return h.target != nil }) {
+		return fmt.Errorf("%s\n\n%s", self.c.Tr.NoHunksToAbsorb, table)
+	}
+	if lo.SomeBy(hunks, func(h *absorbHunk) bool { return h.target == nil }) {
+		table += "\n\n" + self.c.Tr.AbsorbUnassignedHunksStayStaged
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.AbsorbStagedChangesTitle,
+		Prompt: table,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.AbsorbCreateFixupCommits,
+				Key:   'f',
+				OnPress: func() error {
+					return self.absorb(patches, hunks, false)
+				},
+			},
+			{
+				Label: self.c.Tr.AbsorbCreateFixupCommitsAndSquash,
+				Key:   's',
+				OnPress: func() error {
+					return self.absorb(patches, hunks, true)
+				},
+			},
+		},
+	})
+}
+
+// Blames each hunk of the given zero-context file diffs and sets its target
+// commit, or the reason why it doesn't have one
+func (self *FixupHelper) findBaseCommitsForHunks(fileDiffs []string, patches []*patch.Patch, hunks []*absorbHunk) error {
+	commits := self.c.Model().Commits
+
+	// The file names that we need to blame are the old ones, so we can't use
+	// the paths of the hunks
+	oldFilenames := lo.Map(fileDiffs, func(fileDiff string, _ int) string {
+		for _, line := range strings.Split(fileDiff, "\n") {
+			if strings.HasPrefix(line, "--- a/") {
+				// For some reason, the line ends with a tab character if the
+				// file name contains spaces
+				return strings.TrimRight(line[6:], "\t")
+			}
+		}
+		return ""
+	})
+
+	errg := errgroup.Group{}
+	for _, h := range hunks {
+		errg.Go(func() error {
+			filename := oldFilenames[h.patchIdx]
+			if filename == "" {
+				h.unassignedReason = self.c.Tr.AbsorbHunkInNewFile
+				return nil
+			}
+
+			startLineIdx, numLines := patches[h.patchIdx].HunkOldRange(h.hunkIdx)
+			var hashes []string
+			if numLines > 0 {
+				var err error
+				hashes, err = self.blameDeletedLinesOfHunk(&hunk{filename, startLineIdx, numLines})
+				if err != nil {
+					return err
+				}
+			} else {
+				// If we can't blame the lines around the hunk, we can't tell
+				// where it belongs, but this shouldn't prevent absorbing the
+				// other hunks
+				hashesAround, _ := self.blameLinesAroundHunk(&hunk{filename, startLineIdx, 0})
+				if len(hashesAround) > 0 {
+					if hash, ok := self.pickHashForAddedLines(commits, hashesAround); ok {
+						hashes = []string{hash}
+					}
+				}
+			}
+
+			if len(hashes) == 0 {
+				h.unassignedReason = self.c.Tr.NoBaseCommitsFound
+				return nil
+			}
+
+			foundCommits, err := self.findBaseCommits(commits, hashes)
+			if err != nil {
+				h.unassignedReason = err.Error()
+			} else if len(foundCommits) > 1 {
+				h.unassignedReason = fmt.Sprintf(self.c.Tr.AbsorbMultipleBaseCommits,
+					strings.Join(lo.Map(foundCommits, func(c *models.Commit, _ int) string { return c.ShortRefName() }), ", "))
+			} else {
+				h.target = foundCommits[0]
+			}
+			return nil
+		})
+	}
+
+	return errg.Wait()
+}
+
+func (self *FixupHelper) formatAbsorbTable(hunks []*absorbHunk) string {
+	rows := lo.Map(hunks, func(h *absorbHunk, _ int) []string {
+		location := h.path
+		if h.lineNumber > 0 {
+			location = fmt.Sprintf("%s:%d", h.path, h.lineNumber)
+		}
+		if h.target == nil {
+			return []string{location, "", style.FgRed.Sprint(h.unassignedReason)}
+		}
+		return []string{location, style.FgYellow.Sprint(h.target.ShortRefName()), h.target.Name}
+	})
+
+	lines, _ := utils.RenderDisplayStrings(rows, nil)
+	return strings.Join(lines, "\n")
+}
+
+// Creates a fixup commit for each target commit of the hunks, containing only
+// the hunks that belong to it. Each fixup commit is built up in a temporary
+// index file that starts out from HEAD, so the repository's index and the
+// working tree are left untouched; afterwards, the index only differs from
+// HEAD by the hunks without a target commit. If creating one of the fixup
+// commits fails (e.g. because of a hook), the hunks that haven't been
+// committed yet are therefore still staged.
+func (self *FixupHelper) absorb(patches []*patch.Patch, hunks []*absorbHunk, autosquash bool) error {
+	// Ordered from oldest to newest, so that the fixup commits end up in the
+	// same order as the commits they belong to
+	targets := lo.Reverse(lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return lo.SomeBy(hunks, func(h *absorbHunk) bool { return h.target == commit })
+	}))
+
+	self.c.LogAction(self.c.Tr.Actions.AbsorbStagedChanges)
+	return self.c.WithWaitingStatusSync(self.c.Tr.CreatingFixupCommitsStatus, func() error {
+		err := self.createFixupCommits(patches, hunks, targets)
+		if err != nil || !autosquash {
+			self.c.Refresh(types.RefreshOptions{Mode: types.SYNC})
+			return err
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.SquashAllAboveFixupCommits)
+		err = self.c.Git().Rebase.SquashAllAboveFixupCommits(targets[0])
+		return self.mergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+			err, types.RefreshOptions{Mode: types.SYNC})
+	})
+}
+
+func (self *FixupHelper) createFixupCommits(patches []*patch.Patch, hunks []*absorbHunk, targets []*models.Commit) error {
+	tempDir, err := os.MkdirTemp(self.c.OS().GetTempDir(), "absorb-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+	indexFile := filepath.Join(tempDir, "index")
+
+	for _, target := range targets {
+		if err := self.c.Git().WorkingTree.ReadTreeIntoIndexFile("HEAD", indexFile); err != nil {
+			return err
+		}
+		if err := self.stageHunks(indexFile, patches, hunks, func(h *absorbHunk) bool { return h.target == target }); err != nil {
+			return err
+		}
+		if err := self.c.Git().Commit.CreateFixupCommitFromIndexFile(target.Hash(), indexFile); err != nil {
+			return err
+		}
+		for _, h := range hunks {
+			h.committed = h.committed || h.target == target
+		}
+	}
+
+	return nil
+}
+
+// Stages the hunks for which include returns true in the given index file,
+// which contains HEAD, i.e. the hunks that have been committed already
+func (self *FixupHelper) stageHunks(indexFile string, patches []*patch.Patch, hunks []*absorbHunk, include func(*absorbHunk) bool) error {
+	patchStr := ""
+	for i, filePatch := range patches {
+		fileHunks := lo.Filter(hunks, func(h *absorbHunk, _ int) bool { return h.patchIdx == i })
+		hunkIndices := lo.FilterMap(fileHunks, func(h *absorbHunk, _ int) (int, bool) {
+			return h.hunkIdx, include(h)
+		})
+		if len(hunkIndices) == 0 {
+			continue
+		}
+		committedHunkIndices := lo.FilterMap(fileHunks, func(h *absorbHunk, _ int) (int, bool) {
+			return h.hunkIdx, h.committed
+		})
+		patchStr += filePatch.SelectHunks(hunkIndices, committedHunkIndices).FormatPlain()
+	}
+
+	if patchStr == "" {
+		return nil
+	}
+
+	return self.c.Git().Patch.ApplyPatch(patchStr, git_commands.ApplyPatchOpts{
+		Cached:      true,
+		UnidiffZero: true,
+		IndexFile:   indexFile,
+	})
+}
+
+// Returns the commits of the current branch that introduced the lines with the
+// given hashes, leaving out fixups for other found commits. Returns an error if
+// any of them can't be found, or if all of them are already merged.
+func (self *FixupHelper) findBaseCommits(commits []*models.Commit, hashes []string) ([]*models.Commit, error) {
 	// If a commit can't be found, and the last known commit is already merged,
 	// we know that the commit we're looking for is also merged. Otherwise we
 	// can't tell.
@@ -100,52 +417,21 @@ func (self *FixupHelper) HandleFindBaseCommitForFixupPress() error {
 		// branch. Both are so unlikely that we don't bother returning a more
 		// detailed error message (e.g. we could say something about the commits
 		// that *are* in the current branch, but it's not worth it).
-		return errors.New(self.c.Tr.BaseCommitIsNotInCurrentView)
+		return nil, errors.New(self.c.Tr.BaseCommitIsNotInCurrentView)
 	}
 
 	if len(hashGroups[NOT_MERGED]) == 0 {
 		// If all the commits are merged, show the "already on main branch"
 		// error. It isn't worth doing a detailed report of which commits we
 		// found.
-		return errors.New(self.c.Tr.BaseCommitIsAlreadyOnMainBranch)
+		return nil, errors.New(self.c.Tr.BaseCommitIsAlreadyOnMainBranch)
 	}
 
 	foundCommits := getCommitsForHashes(commits, hashGroups[NOT_MERGED])
 	// If there are multiple commits that could be the base commit, remove all
 	// those that are fixups for the last one.
 	foundCommits = removeFixupCommits(foundCommits)
-
-	if len(foundCommits) > 1 {
-		// If there are still multiple commits that could be the base commit, list
-		// them in the error message. But only the candidates from the current
-		// branch, not including any that are already merged.
-		subjects := getHashesAndSubjects(foundCommits)
-		message := lo.Ternary(hasStagedChanges,
-			self.c.Tr.MultipleBaseCommitsFoundStaged,
-			self.c.Tr.MultipleBaseCommitsFoundUnstaged)
-		return fmt.Errorf("%s\n\n%s", message, subjects)
-	}
-
-	// Now we know that foundCommits has exactly one commit, so find its index
-	_, index, _ := self.findCommit(commits, foundCommits[0].Hash())
-
-	return self.c.ConfirmIf(warnAboutAddedLines, types.ConfirmOpts{
-		Title:  self.c.Tr.FindBaseCommitForFixup,
-		Prompt: self.c.Tr.HunksWithOnlyAddedLinesWarning,
-		HandleConfirm: func() error {
-			if !hasStagedChanges {
-				if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
-					return err
-				}
-				self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
-			}
-
-			self.c.Contexts().LocalCommits.SetSelection(index)
-			self.c.Contexts().LocalCommits.FocusLine(true)
-			self.c.Context().Push(self.c.Contexts().LocalCommits, types.OnFocusOpts{})
-			return nil
-		},
-	})
+	return foundCommits, nil
 }
 
 func getCommitsForHashes(commits []*models.Commit, hashes []string) []*models.Commit {
@@ -269,13 +555,12 @@ func (self *FixupHelper) blameDeletedLines(deletedLineHunks []*hunk) ([]string,
 
 	for _, h := range deletedLineHunks {
 		errg.Go(func() error {
-			blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
+			hashes, err := self.blameDeletedLinesOfHunk(h)
 			if err != nil {
 				return err
 			}
-			blameLines := strings.SplitSeq(strings.TrimSuffix(blameOutput, "\n"), "\n")
-			for line := range blameLines {
-				hashChan <- strings.Split(line, " ")[0]
+			for _, hash := range hashes {
+				hashChan <- hash
 			}
 			return nil
 		})
@@ -297,46 +582,25 @@ func (self *FixupHelper) blameDeletedLines(deletedLineHunks []*hunk) ([]string,
 	return result.ToSlice(), errg.Wait()
 }
 
+func (self *FixupHelper) blameDeletedLinesOfHunk(h *hunk) ([]string, error) {
+	blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, h.numLines)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(strings.Split(strings.TrimSuffix(blameOutput, "\n"), "\n"), func(line string, _ int) string {
+		return strings.Split(line, " ")[0]
+	}), nil
+}
+
 func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunks []*hunk) ([]string, error) {
 	errg := errgroup.Group{}
 	hashesChan := make(chan []string)
 
 	for _, h := range addedLineHunks {
 		errg.Go(func() error {
-			result := make([]string, 0, 2)
-
-			appendBlamedLine := func(blameOutput string) {
-				blameLines := strings.Split(strings.TrimSuffix(blameOutput, "\n"), "\n")
-				if len(blameLines) == 1 {
-					result = append(result, strings.Split(blameLines[0], " ")[0])
-				}
-			}
-
-			// Blame the line before this hunk, if there is one
-			if h.startLineIdx > 0 {
-				blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, 1)
-				if err != nil {
-					return err
-				}
-				appendBlamedLine(blameOutput)
-			}
-
-			// Blame the line after this hunk. We don't know how many lines the
-			// file has, so we can't check if there is a line after the hunk;
-			// let the error tell us.
-			blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx+1, 1)
+			result, err := self.blameLinesAroundHunk(h)
 			if err != nil {
-				// If this fails, we're probably at the end of the file (we
-				// could have checked this beforehand, but it's expensive). If
-				// there was a line before this hunk, this is fine, we'll just
-				// return that one; if not, the hunk encompasses the entire
-				// file, and we can't blame the lines before and after the hunk.
-				// This is an error.
-				if h.startLineIdx == 0 {
-					return errors.New("Entire file") // TODO i18n
-				}
-			} else {
-				appendBlamedLine(blameOutput)
+				return err
 			}
 
 			hashesChan <- result
@@ -354,30 +618,79 @@ func (self *FixupHelper) blameAddedLines(commits []*models.Commit, addedLineHunk
 
 	result := set.New[string]()
 	for hashes := range hashesChan {
-		if len(hashes) == 1 {
-			result.Add(hashes[0])
-		} else if len(hashes) > 1 {
-			if hashes[0] == hashes[1] {
-				result.Add(hashes[0])
-			} else {
-				_, index1, ok1 := self.findCommit(commits, hashes[0])
-				_, index2, ok2 := self.findCommit(commits, hashes[1])
-				if ok1 && ok2 {
-					result.Add(lo.Ternary(index1 < index2, hashes[0], hashes[1]))
-				} else if ok1 {
-					result.Add(hashes[0])
-				} else if ok2 {
-					result.Add(hashes[1])
-				} else {
-					return nil, errors.New(self.c.Tr.NoBaseCommitsFound)
-				}
+		if len(hashes) > 0 {
+			hash, ok := self.pickHashForAddedLines(commits, hashes)
+			if !ok {
+				return nil, errors.New(self.c.Tr.NoBaseCommitsFound)
 			}
+			result.Add(hash)
 		}
 	}
 
 	return result.ToSlice(), errg.Wait()
 }
 
+// Returns the hashes of the commits that introduced the lines before and after
+// the given hunk of added lines
+func (self *FixupHelper) blameLinesAroundHunk(h *hunk) ([]string, error) {
+	result := make([]string, 0, 2)
+
+	appendBlamedLine := func(blameOutput string) {
+		blameLines := strings.Split(strings.TrimSuffix(blameOutput, "\n"), "\n")
+		if len(blameLines) == 1 {
+			result = append(result, strings.Split(blameLines[0], " ")[0])
+		}
+	}
+
+	// Blame the line before this hunk, if there is one
+	if h.startLineIdx > 0 {
+		blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx, 1)
+		if err != nil {
+			return nil, err
+		}
+		appendBlamedLine(blameOutput)
+	}
+
+	// Blame the line after this hunk. We don't know how many lines the
+	// file has, so we can't check if there is a line after the hunk;
+	// let the error tell us.
+	blameOutput, err := self.c.Git().Blame.BlameLineRange(h.filename, "HEAD", h.startLineIdx+1, 1)
+	if err != nil {
+		// If this fails, we're probably at the end of the file (we
+		// could have checked this beforehand, but it's expensive). If
+		// there was a line before this hunk, this is fine, we'll just
+		// return that one; if not, the hunk encompasses the entire
+		// file, and we can't blame the lines before and after the hunk.
+		// This is an error.
+		if h.startLineIdx == 0 {
+			return nil, errors.New("Entire file") // TODO i18n
+		}
+	} else {
+		appendBlamedLine(blameOutput)
+	}
+
+	return result, nil
+}
+
+// Given the hashes of the lines before and after a hunk of added lines, returns
+// the one that the hunk most likely belongs to, which is the more recent one
+func (self *FixupHelper) pickHashForAddedLines(commits []*models.Commit, hashes []string) (string, bool) {
+	if len(hashes) == 1 || hashes[0] == hashes[1] {
+		return hashes[0], true
+	}
+
+	_, index1, ok1 := self.findCommit(commits, hashes[0])
+	_, index2, ok2 := self.findCommit(commits, hashes[1])
+	if ok1 && ok2 {
+		return lo.Ternary(index1 < index2, hashes[0], hashes[1]), true
+	} else if ok1 {
+		return hashes[0], true
+	} else if ok2 {
+		return hashes[1], true
+	}
+	return "", false
+}
+
 func (self *FixupHelper) findCommit(commits []*models.Commit, hash string) (*models.Commit, int, bool) {
 	return lo.FindIndexOf(commits, func(commit *models.Commit) bool {
 		return commit.Hash() == hash
@@ -24,6 +24,13 @@ func NewGpgHelper(c *HelperCommon) *GpgHelper {
 // fix this bug, or just stop running subprocesses from within there, given that
 // we don't need to see a loading status if we're in a subprocess.
 func (self *GpgHelper) WithGpgHandling(cmdObj *oscommands.CmdObj, configKey git_commands.GpgConfigKey, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
+	return self.WithGpgHandlingAndFailureHandler(cmdObj, configKey, waitingStatus, onSuccess, nil, refreshScope)
+}
+
+// WithGpgHandlingAndFailureHandler is like WithGpgHandling, but if the command
+// fails and onFailure is not nil, it is called with the command's error instead
+// of showing the error to the user.
+func (self *GpgHelper) WithGpgHandlingAndFailureHandler(cmdObj *oscommands.CmdObj, configKey git_commands.GpgConfigKey, waitingStatus string, onSuccess func() error, onFailure func(error) error, refreshScope []types.RefreshableView) error {
 	useSubprocess := self.c.Git().Config.NeedsGpgSubprocess(configKey)
 	if useSubprocess {
 		success, err := self.c.RunSubprocess(cmdObj)
@@ -34,16 +41,22 @@ func (self *GpgHelper) WithGpgHandling(cmdObj *oscommands.CmdObj, configKey git_
 		}
 		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: refreshScope})
 
+		if !success && onFailure != nil {
+			return onFailure(err)
+		}
 		return err
 	}
 
-	return self.runAndStream(cmdObj, waitingStatus, onSuccess, refreshScope)
+	return self.runAndStream(cmdObj, waitingStatus, onSuccess, onFailure, refreshScope)
 }
 
-func (self *GpgHelper) runAndStream(cmdObj *oscommands.CmdObj, waitingStatus string, onSuccess func() error, refreshScope []types.RefreshableView) error {
+func (self *GpgHelper) runAndStream(cmdObj *oscommands.CmdObj, waitingStatus string, onSuccess func() error, onFailure func(error) error, refreshScope []types.RefreshableView) error {
 	return self.c.WithWaitingStatus(waitingStatus, func(gocui.Task) error {
 		if err := cmdObj.StreamOutput().Run(); err != nil {
 			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: refreshScope})
+			if onFailure != nil {
+				return onFailure(err)
+			}
 			return fmt.Errorf(
 				self.c.Tr.GitCommandFailed, self.c.UserConfig().Keybinding.Universal.ExtrasMenu,
 			)
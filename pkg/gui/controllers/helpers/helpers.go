@@ -39,6 +39,19 @@ type Helpers struct {
 	Snake          *SnakeHelper
 	// lives in context package because our contexts need it to render to main
 	Diff              *DiffHelper
+	CommitGenerator   *CommitGeneratorHelper
+	CommitLint        *CommitLintHelper
+	Trailers          *TrailersHelper
+	CommitTemplates   *CommitTemplatesHelper
+	PreCommitHooks    *PreCommitHooksHelper
+	Blame             *BlameHelper
+	Lfs               *LfsHelper
+	SparseCheckout    *SparseCheckoutHelper
+	RangeDiff         *RangeDiffHelper
+	BranchStack       *BranchStackHelper
+	PatchSeries       *PatchSeriesHelper
+	Rerere            *RerereHelper
+	RepoHealth        *RepoHealthHelper
 	Repos             *ReposHelper
 	RecordDirectory   *RecordDirectoryHelper
 	Update            *UpdateHelper
@@ -74,6 +87,19 @@ func NewStubHelpers() *Helpers {
 		AmendHelper:       &AmendHelper{},
 		FixupHelper:       &FixupHelper{},
 		Commits:           &CommitsHelper{},
+		CommitGenerator:   &CommitGeneratorHelper{},
+		CommitLint:        &CommitLintHelper{},
+		Trailers:          &TrailersHelper{},
+		CommitTemplates:   &CommitTemplatesHelper{},
+		PreCommitHooks:    &PreCommitHooksHelper{},
+		Blame:             &BlameHelper{},
+		Lfs:               &LfsHelper{},
+		SparseCheckout:    &SparseCheckoutHelper{},
+		RangeDiff:         &RangeDiffHelper{},
+		BranchStack:       &BranchStackHelper{},
+		PatchSeries:       &PatchSeriesHelper{},
+		Rerere:            &RerereHelper{},
+		RepoHealth:        &RepoHealthHelper{},
 		Snake:             &SnakeHelper{},
 		Diff:              &DiffHelper{},
 		Repos:             &ReposHelper{},
@@ -0,0 +1,217 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type LfsHelper struct {
+	c                 *HelperCommon
+	suggestionsHelper *SuggestionsHelper
+}
+
+func NewLfsHelper(c *HelperCommon, suggestionsHelper *SuggestionsHelper) *LfsHelper {
+	return &LfsHelper{
+		c:                 c,
+		suggestionsHelper: suggestionsHelper,
+	}
+}
+
+// The two versions of a file between which we show how its LFS object
+// changed. See LfsCommands.GetPointer for what versions can be.
+type LfsPointerDiffVersions struct {
+	Old string
+	New string
+	// The path of the file in the old version if it was renamed, or empty
+	// if it has the same path in both
+	OldPath string
+}
+
+// RenderPointerDiffs renders the given main view update, but with the tasks of
+// its views replaced by descriptions of how the LFS object of the file at the
+// given path changed between the given versions; we show these instead of
+// diffs of the pointer files. The secondary view is left alone if
+// secondaryVersions is nil. Getting the LFS object of a file in the working
+// tree involves hashing it, which can take a while for big files, so we do
+// that in the background and only render the result if the file is still
+// selected by then.
+func (self *LfsHelper) RenderPointerDiffs(
+	opts types.RefreshMainOpts,
+	path string,
+	mainVersions LfsPointerDiffVersions,
+	secondaryVersions *LfsPointerDiffVersions,
+	isStillSelected func() bool,
+) {
+	showSecondary := opts.Secondary != nil && secondaryVersions != nil
+
+	self.c.OnWorker(func(gocui.Task) error {
+		mainDiff := self.getPointerDiff(path, mainVersions)
+		secondaryDiff := ""
+		if showSecondary {
+			secondaryDiff = self.getPointerDiff(path, *secondaryVersions)
+		}
+
+		self.c.OnUIThread(func() error {
+			if !isStillSelected() {
+				return nil
+			}
+
+			opts.Main.Task = types.NewRenderStringTask(mainDiff)
+			if showSecondary {
+				opts.Secondary.Task = types.NewRenderStringTask(secondaryDiff)
+			}
+			self.c.RenderToMainViews(opts)
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *LfsHelper) getPointerDiff(path string, versions LfsPointerDiffVersions) string {
+	oldPointer, err := self.c.Git().Lfs.GetPointer(versions.Old, lo.Ternary(versions.OldPath != "", versions.OldPath, path))
+	if err != nil {
+		return err.Error()
+	}
+	newPointer, err := self.c.Git().Lfs.GetPointer(versions.New, path)
+	if err != nil {
+		return err.Error()
+	}
+
+	return presentation.GetLfsPointerDiff(oldPointer, newPointer, self.c.Tr)
+}
+
+func (self *LfsHelper) OpenLfsMenu(node *filetree.FileNode) error {
+	var fileDisabledReason *types.DisabledReason
+	if !node.IsFile() {
+		fileDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotLockDirectory}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewLfsOptions,
+		Items: []*types.MenuItem{
+			{
+				Label:          self.c.Tr.LfsLockFile,
+				OnPress:        func() error { return self.lockFile(node.GetPath()) },
+				Key:            'l',
+				Tooltip:        self.c.Tr.LfsLockFileTooltip,
+				DisabledReason: fileDisabledReason,
+			},
+			{
+				Label:          self.c.Tr.LfsUnlockFile,
+				OnPress:        func() error { return self.unlockFile(node.GetPath()) },
+				Key:            'u',
+				Tooltip:        self.c.Tr.LfsUnlockFileTooltip,
+				DisabledReason: fileDisabledReason,
+			},
+			{
+				Label:     self.c.Tr.LfsListLocks,
+				OnPress:   self.listLocks,
+				Key:       'L',
+				Tooltip:   self.c.Tr.LfsListLocksTooltip,
+				OpensMenu: true,
+			},
+		},
+	})
+}
+
+func (self *LfsHelper) lockFile(path string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LockingFileStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsLockFile)
+		return self.c.Git().Lfs.Lock(task, path)
+	})
+}
+
+func (self *LfsHelper) unlockFile(path string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UnlockingFileStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+		return self.c.Git().Lfs.Unlock(task, path)
+	})
+}
+
+func (self *LfsHelper) listLocks() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingLfsLocks, func(task gocui.Task) error {
+		locks, err := self.c.Git().Lfs.GetLocks(task)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(locks) == 0 {
+				self.c.Alert(self.c.Tr.LfsLocksTitle, self.c.Tr.LfsNoLocks)
+				return nil
+			}
+
+			menuItems := lo.Map(locks, func(lock *models.LfsLock, _ int) *types.MenuItem {
+				return &types.MenuItem{
+					LabelColumns: []string{lock.Path, lock.Owner, fmt.Sprintf("#%s", lock.ID)},
+					OnPress:      func() error { return self.confirmUnlock(lock) },
+				}
+			})
+
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: self.c.Tr.LfsLocksTitle,
+				Items: menuItems,
+			})
+		})
+		return nil
+	})
+}
+
+func (self *LfsHelper) confirmUnlock(lock *models.LfsLock) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.LfsUnlockFile,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.LfsForceUnlockPrompt, map[string]string{
+			"path":  lock.Path,
+			"owner": lock.Owner,
+		}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.UnlockingFileStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+				return self.c.Git().Lfs.UnlockByID(task, lock.ID, true)
+			})
+		},
+	})
+
+	return nil
+}
+
+// FetchObjects downloads the LFS objects of the given commits from a remote
+// that the user picks
+func (self *LfsHelper) FetchObjects(commits []*models.Commit) error {
+	hashes := lo.FilterMap(commits, func(commit *models.Commit, _ int) (string, bool) {
+		return commit.Hash(), commit.Hash() != ""
+	})
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.FetchLfsObjectsTitle,
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.suggestionsHelper.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.FetchingLfsObjectsStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.FetchLfsObjects)
+				return self.c.Git().Lfs.Fetch(task, remoteName, hashes)
+			})
+		},
+	})
+
+	return nil
+}
+
+// The versions to compare for showing the LFS object changes of a file in the
+// working tree, either staged or unstaged. previousPath is the path of the file
+// in HEAD if the file was renamed in the index.
+func WorkingTreeLfsPointerDiffVersions(staged bool, previousPath string) LfsPointerDiffVersions {
+	if staged {
+		return LfsPointerDiffVersions{Old: "HEAD", New: git_commands.LfsIndexVersion, OldPath: previousPath}
+	}
+
+	return LfsPointerDiffVersions{Old: git_commands.LfsIndexVersion, New: git_commands.LfsWorkingTreeVersion}
+}
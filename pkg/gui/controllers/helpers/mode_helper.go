@@ -77,12 +77,11 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 		{
 			IsActive: self.c.Modes().Filtering.Active,
 			InfoLabel: func() string {
-				filterContent := lo.Ternary(self.c.Modes().Filtering.GetPath() != "", self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor())
 				return self.withResetButton(
 					fmt.Sprintf(
-						"%s '%s'",
+						"%s %s",
 						self.c.Tr.FilteringBy,
-						filterContent,
+						self.filteringDescription(),
 					),
 					style.FgRed,
 				)
@@ -158,6 +157,28 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 	}
 }
 
+// Describes the active filters, e.g. "'my/path' -S 'foo'"
+func (self *ModeHelper) filteringDescription() string {
+	filtering := self.c.Modes().Filtering
+	parts := []string{}
+
+	filterContent := lo.Ternary(filtering.GetPath() != "", filtering.GetPath(), filtering.GetAuthor())
+	if lineRange := filtering.GetLineRangeArg(); lineRange != "" {
+		filterContent = lineRange
+	}
+	if filterContent != "" {
+		parts = append(parts, fmt.Sprintf("'%s'", filterContent))
+	}
+	if pickaxe := filtering.GetPickaxe(); pickaxe != "" {
+		parts = append(parts, fmt.Sprintf("%s '%s'", lo.Ternary(filtering.PickaxeIsRegex(), "-G", "-S"), pickaxe))
+	}
+	if messagePattern := filtering.GetMessagePattern(); messagePattern != "" {
+		parts = append(parts, fmt.Sprintf("--grep '%s'", messagePattern))
+	}
+
+	return strings.Join(parts, " ")
+}
+
 func (self *ModeHelper) withResetButton(content string, textStyle style.TextStyle) string {
 	return textStyle.Sprintf(
 		"%s %s",
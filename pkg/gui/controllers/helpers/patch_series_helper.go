@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Exports commits as a series of patch files for sending by email, and applies
+// such patches with git am
+type PatchSeriesHelper struct {
+	c *HelperCommon
+
+	suggestionsHelper    *SuggestionsHelper
+	mergeAndRebaseHelper *MergeAndRebaseHelper
+}
+
+func NewPatchSeriesHelper(
+	c *HelperCommon,
+	suggestionsHelper *SuggestionsHelper,
+	mergeAndRebaseHelper *MergeAndRebaseHelper,
+) *PatchSeriesHelper {
+	return &PatchSeriesHelper{
+		c:                    c,
+		suggestionsHelper:    suggestionsHelper,
+		mergeAndRebaseHelper: mergeAndRebaseHelper,
+	}
+}
+
+// OpenExportMenu asks how to export the given commits, which are ordered
+// newest first like in the commits panel
+func (self *PatchSeriesHelper) OpenExportMenu(commits []*models.Commit) error {
+	opts := git_commands.FormatPatchOpts{
+		From: commits[len(commits)-1],
+		To:   commits[0],
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ExportPatchSeries,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ExportPatches,
+				OnPress: func() error {
+					return self.promptForOutputDir(opts)
+				},
+				Key: 'p',
+			},
+			{
+				Label: self.c.Tr.ExportPatchesWithCoverLetter,
+				OnPress: func() error {
+					opts.CoverLetter = true
+					return self.promptForOutputDir(opts)
+				},
+				Key:     'c',
+				Tooltip: self.c.Tr.ExportPatchesWithCoverLetterTooltip,
+			},
+		},
+	})
+}
+
+func (self *PatchSeriesHelper) promptForOutputDir(opts git_commands.FormatPatchOpts) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PatchOutputDirectoryPrompt,
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(outputDir string) error {
+			opts.OutputDir = outputDir
+			return self.export(opts)
+		},
+	})
+
+	return nil
+}
+
+func (self *PatchSeriesHelper) export(opts git_commands.FormatPatchOpts) error {
+	return self.c.WithWaitingStatus(self.c.Tr.ExportingPatchesStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.ExportPatches)
+		paths, err := self.c.Git().PatchSeries.FormatPatch(opts)
+		if err != nil {
+			return err
+		}
+
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.PatchesExportedToast, map[string]string{
+			"count": strconv.Itoa(len(paths)),
+			"dir":   opts.OutputDir,
+		}))
+		return nil
+	})
+}
+
+// ApplyMailbox asks for an mbox or patch file and applies its patches as
+// commits on top of HEAD. If they don't apply cleanly, the user can resolve
+// the conflicts and continue, skip, or abort via the merge/rebase options.
+func (self *PatchSeriesHelper) ApplyMailbox() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.MailboxPathPrompt,
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.ApplyingPatchesStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.ApplyMailbox)
+				err := self.c.Git().PatchSeries.ApplyMailbox(path)
+				return self.mergeAndRebaseHelper.CheckMergeOrRebase(err)
+			})
+		},
+	})
+
+	return nil
+}
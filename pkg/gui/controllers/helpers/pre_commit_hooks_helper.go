@@ -0,0 +1,174 @@
+package helpers
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type PreCommitHooksHelper struct {
+	c                 *HelperCommon
+	workingTreeHelper *WorkingTreeHelper
+}
+
+func NewPreCommitHooksHelper(c *HelperCommon, workingTreeHelper *WorkingTreeHelper) *PreCommitHooksHelper {
+	return &PreCommitHooksHelper{
+		c:                 c,
+		workingTreeHelper: workingTreeHelper,
+	}
+}
+
+// RunPreCommitHooks runs the repo's pre-commit hooks against the staged files
+// without committing, and shows the result of each hook in a popup. Files
+// that the hooks modified can be staged again from there.
+func (self *PreCommitHooksHelper) RunPreCommitHooks() error {
+	if reason := self.GetDisabledReason(); reason != nil {
+		return errors.New(reason.Text)
+	}
+	kind := self.c.Model().PreCommitHooks
+
+	stagedFiles := lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		return file.HasStagedChanges
+	})
+	if len(stagedFiles) == 0 {
+		return errors.New(self.c.Tr.NoStagedFilesToRunHooksOn)
+	}
+
+	// Only regular files can be modified by a hook and hashed; not deleted
+	// files, nor directories such as submodules
+	worktreePath := self.c.Git().RepoPaths.WorktreePath()
+	stagedPaths := lo.FilterMap(stagedFiles, func(file *models.File, _ int) (string, bool) {
+		info, err := os.Lstat(filepath.Join(worktreePath, file.Path))
+		return file.Path, err == nil && info.Mode().IsRegular()
+	})
+
+	return self.c.WithWaitingStatus(self.c.Tr.RunningPreCommitHooksStatus, func(gocui.Task) error {
+		hashesBefore, err := self.c.Git().WorkingTree.HashFiles(stagedPaths)
+		if err != nil {
+			return err
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.RunPreCommitHooks)
+		results, err := self.c.Git().Hook.RunPreCommitHooks(kind)
+		if err != nil {
+			return err
+		}
+
+		hashesAfter, err := self.c.Git().WorkingTree.HashFiles(stagedPaths)
+		if err != nil {
+			return err
+		}
+		modifiedPaths := lo.Filter(stagedPaths, func(_ string, i int) bool {
+			return i < len(hashesBefore) && i < len(hashesAfter) && hashesBefore[i] != hashesAfter[i]
+		})
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+
+		self.c.OnUIThread(func() error {
+			return self.showResults(results, modifiedPaths)
+		})
+		return nil
+	})
+}
+
+func (self *PreCommitHooksHelper) GetDisabledReason() *types.DisabledReason {
+	switch self.c.Model().PreCommitHooks {
+	case git_commands.NoPreCommitHooks:
+		return &types.DisabledReason{Text: self.c.Tr.NoPreCommitHooks}
+	case git_commands.PreCommitFrameworkHooks:
+		if !self.c.Model().PreCommitFrameworkInstalled {
+			return &types.DisabledReason{Text: self.c.Tr.PreCommitNotInstalled}
+		}
+	}
+
+	return nil
+}
+
+func (self *PreCommitHooksHelper) showResults(results []git_commands.HookResult, modifiedPaths []string) error {
+	menuItems := lo.Map(results, func(result git_commands.HookResult, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{hookStatusIcon(result.Status), result.Name},
+			Tooltip:      result.Output,
+			OnPress: func() error {
+				if result.Output != "" {
+					self.c.Alert(result.Name, result.Output)
+				}
+				return nil
+			},
+		}
+	})
+
+	var disabledReasonForStaging *types.DisabledReason
+	if len(modifiedPaths) == 0 {
+		disabledReasonForStaging = &types.DisabledReason{Text: self.c.Tr.NoFilesModifiedByHooks}
+	}
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:          self.c.Tr.StageFilesModifiedByHooks,
+		Tooltip:        strings.Join(modifiedPaths, "\n"),
+		OnPress:        func() error { return self.stageFiles(modifiedPaths) },
+		Key:            's',
+		DisabledReason: disabledReasonForStaging,
+	})
+
+	skipHookPrefix := self.c.UserConfig().Git.SkipHookPrefix
+	var disabledReasonForSkipping *types.DisabledReason
+	if skipHookPrefix == "" {
+		disabledReasonForSkipping = &types.DisabledReason{Text: self.c.Tr.SkipHookPrefixNotConfigured}
+	}
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.SkipHooksForNextCommit,
+		Tooltip: utils.ResolvePlaceholderString(self.c.Tr.SkipHooksForNextCommitTooltip,
+			map[string]string{"prefix": skipHookPrefix}),
+		OnPress:        func() error { return self.skipHooksForNextCommit(skipHookPrefix) },
+		Key:            'k',
+		DisabledReason: disabledReasonForSkipping,
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PreCommitHookResultsTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *PreCommitHooksHelper) stageFiles(paths []string) error {
+	self.c.LogAction(self.c.Tr.Actions.StageFilesModifiedByHooks)
+	if err := self.c.Git().WorkingTree.StageFiles(paths, nil); err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.STAGING}})
+	return nil
+}
+
+// Committing a message that starts with the skip hook prefix skips the hooks,
+// so we put the prefix in front of the message in the commit input
+func (self *PreCommitHooksHelper) skipHooksForNextCommit(skipHookPrefix string) error {
+	message := self.c.Views().CommitInput.TextArea.GetContent()
+	if !strings.HasPrefix(message, skipHookPrefix) {
+		separator := lo.Ternary(message == "" || strings.HasSuffix(skipHookPrefix, " "), "", " ")
+		self.workingTreeHelper.SetCommitInputContent(skipHookPrefix + separator + message)
+	}
+
+	self.c.Context().Push(self.c.Contexts().CommitInput, types.OnFocusOpts{})
+	return nil
+}
+
+func hookStatusIcon(status git_commands.HookStatus) string {
+	switch status {
+	case git_commands.HookPassed:
+		return style.FgGreen.Sprint("✓")
+	case git_commands.HookFailed:
+		return style.FgRed.Sprint("✗")
+	default:
+		return style.FgYellow.Sprint("-")
+	}
+}
@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type RangeDiffHelper struct {
+	c *HelperCommon
+}
+
+func NewRangeDiffHelper(c *HelperCommon) *RangeDiffHelper {
+	return &RangeDiffHelper{
+		c: c,
+	}
+}
+
+func (self *RangeDiffHelper) OpenMenu(parentContext types.Context) error {
+	upstreamItem := &types.MenuItem{
+		Label:   self.c.Tr.RangeDiffUpstreamVsLocal,
+		Key:     'u',
+		Tooltip: self.c.Tr.RangeDiffUpstreamVsLocalTooltip,
+	}
+	branch := self.checkedOutBranch()
+	if branch == nil || !branch.RemoteBranchStoredLocally() {
+		upstreamItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.RangeDiffNoUpstream}
+	} else {
+		upstreamItem.OnPress = func() error {
+			return self.CompareRefs(branch.ShortUpstreamRefName(), branch.Name, parentContext)
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewRangeDiffOptions,
+		Items: []*types.MenuItem{
+			upstreamItem,
+			{
+				Label:     self.c.Tr.RangeDiffReflogEntryVsHead,
+				OnPress:   func() error { return self.pickReflogEntry(parentContext) },
+				Key:       'r',
+				Tooltip:   self.c.Tr.RangeDiffReflogEntryVsHeadTooltip,
+				OpensMenu: true,
+			},
+			{
+				Label:   self.c.Tr.RangeDiffEnterRanges,
+				OnPress: func() error { return self.enterRanges(parentContext) },
+				Key:     'e',
+				Tooltip: self.c.Tr.RangeDiffEnterRangesTooltip,
+			},
+		},
+	})
+}
+
+// Lets the user pick an earlier state of HEAD from the reflog to compare the
+// current one with
+func (self *RangeDiffHelper) pickReflogEntry(parentContext types.Context) error {
+	// The first entry is the current HEAD
+	if len(self.c.Model().ReflogCommits) < 2 {
+		return errors.New(self.c.Tr.RangeDiffNoReflogEntries)
+	}
+
+	reflogCommits := self.c.Model().ReflogCommits[1:]
+	menuItems := lo.Map(reflogCommits, func(commit *models.Commit, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgBlue.Sprint(commit.ShortHash()),
+				commit.Name,
+			},
+			OnPress: func() error {
+				return self.CompareWithHead(commit, parentContext)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RangeDiffPickReflogEntryTitle,
+		Items: menuItems,
+	})
+}
+
+// CompareWithHead compares the commits that HEAD had as of the given reflog
+// entry with the current ones
+func (self *RangeDiffHelper) CompareWithHead(reflogCommit *models.Commit, parentContext types.Context) error {
+	return self.CompareRefs(reflogCommit.Hash(), "HEAD", parentContext)
+}
+
+func (self *RangeDiffHelper) enterRanges(parentContext types.Context) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.RangeDiffOldRangePrompt,
+		HandleConfirm: func(oldRange string) error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.RangeDiffNewRangePrompt,
+				HandleConfirm: func(newRange string) error {
+					return self.ShowRangeDiff(oldRange, newRange, parentContext)
+				},
+			})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+// CompareRefs compares the commits that the two refs have on top of their
+// merge base, e.g. the upstream and the local version of a branch
+func (self *RangeDiffHelper) CompareRefs(oldRef string, newRef string, parentContext types.Context) error {
+	return self.c.WithWaitingStatus(self.c.Tr.ComparingRangesStatus, func(gocui.Task) error {
+		oldRange, newRange, err := self.c.Git().RangeDiff.RangesSince(oldRef, newRef, self.c.Model().MainBranches)
+		if err != nil {
+			return err
+		}
+
+		title := fmt.Sprintf("%s → %s", shortenRef(oldRef), shortenRef(newRef))
+		return self.load(oldRange, newRange, title, parentContext)
+	})
+}
+
+// ShowRangeDiff shows the pairs of corresponding commits of the two ranges in
+// the range-diff view
+func (self *RangeDiffHelper) ShowRangeDiff(oldRange string, newRange string, parentContext types.Context) error {
+	return self.c.WithWaitingStatus(self.c.Tr.ComparingRangesStatus, func(gocui.Task) error {
+		return self.load(oldRange, newRange, fmt.Sprintf("%s → %s", oldRange, newRange), parentContext)
+	})
+}
+
+func (self *RangeDiffHelper) load(oldRange string, newRange string, title string, parentContext types.Context) error {
+	pairs, err := self.c.Git().RangeDiff.GetPairs(oldRange, newRange)
+	if err != nil {
+		return err
+	}
+	if len(pairs) == 0 {
+		return errors.New(self.c.Tr.RangeDiffNoCommits)
+	}
+
+	self.c.OnUIThread(func() error {
+		self.c.Model().RangeDiffPairs = pairs
+
+		rangeDiffContext := self.c.Contexts().RangeDiff
+		rangeDiffContext.SetRanges(oldRange, newRange)
+		rangeDiffContext.SetSelection(0)
+		rangeDiffContext.SetParentContext(parentContext)
+		rangeDiffContext.SetWindowName(parentContext.GetWindowName())
+		rangeDiffContext.SetTitleRef(utils.TruncateWithEllipsis(title, 50))
+		rangeDiffContext.GetView().Title = rangeDiffContext.Title()
+
+		self.c.PostRefreshUpdate(rangeDiffContext)
+		self.c.Context().Push(rangeDiffContext, types.OnFocusOpts{})
+		return nil
+	})
+	return nil
+}
+
+func (self *RangeDiffHelper) checkedOutBranch() *models.Branch {
+	if len(self.c.Model().Branches) == 0 || !self.c.Model().Branches[0].Head {
+		return nil
+	}
+
+	return self.c.Model().Branches[0]
+}
+
+// Shortens full hashes for the title, but not ref names like HEAD
+func shortenRef(ref string) string {
+	if len(ref) == 40 && strings.Trim(ref, "0123456789abcdef") == "" {
+		return utils.ShortHash(ref)
+	}
+
+	return ref
+}
@@ -1,8 +1,10 @@
 package helpers
 
 import (
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jesseduffield/generics/set"
@@ -27,6 +29,15 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+
+	// The key of the branch stack that is currently in the model (see
+	// BranchStackCommands.GetStackKey), so that we only recompute the stack
+	// when branches have moved
+	branchStackKey      string
+	branchStackKeyMutex sync.Mutex
+	// Incremented whenever we start loading the branch stack, so that the
+	// result of an older load that finishes late doesn't overwrite a newer one
+	branchStackGeneration atomic.Int64
 }
 
 func NewRefreshHelper(
@@ -324,17 +335,24 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 			Limit:                self.c.Contexts().LocalCommits.GetLimitCommits(),
 			FilterPath:           self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:         self.c.Modes().Filtering.GetAuthor(),
+			FilterLineRange:      self.c.Modes().Filtering.GetLineRangeArg(),
+			FilterPickaxe:        self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeIsRegex: self.c.Modes().Filtering.PickaxeIsRegex(),
+			FilterMessage:        self.c.Modes().Filtering.GetMessagePattern(),
 			IncludeRebaseCommits: true,
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutRef,
 			All:                  self.c.Contexts().LocalCommits.GetShowWholeGitGraph(),
 			MainBranches:         self.c.Model().MainBranches,
 			HashPool:             self.c.Model().HashPool,
+			NotesRefs:            self.c.Git().Notes.NotesRefs(),
 		},
 	)
 	if err != nil {
 		return err
 	}
+	self.LoadCommitSignatures(commits, self.c.Contexts().LocalCommits)
+	self.LoadCommitTestResults(commits, self.c.Contexts().LocalCommits)
 	self.c.Model().Commits = commits
 	self.RefreshAuthors(commits)
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
@@ -361,17 +379,24 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 			Limit:                   self.c.Contexts().SubCommits.GetLimitCommits(),
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterLineRange:         self.c.Modes().Filtering.GetLineRangeArg(),
+			FilterPickaxe:           self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeIsRegex:    self.c.Modes().Filtering.PickaxeIsRegex(),
+			FilterMessage:           self.c.Modes().Filtering.GetMessagePattern(),
 			IncludeRebaseCommits:    false,
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
 			RefForPushedStatus:      self.c.Contexts().SubCommits.GetRef(),
 			MainBranches:            self.c.Model().MainBranches,
 			HashPool:                self.c.Model().HashPool,
+			NotesRefs:               self.c.Git().Notes.NotesRefs(),
 		},
 	)
 	if err != nil {
 		return err
 	}
+	self.LoadCommitSignatures(commits, self.c.Contexts().SubCommits)
+	self.LoadCommitTestResults(commits, self.c.Contexts().SubCommits)
 	self.c.Model().SubCommits = commits
 	self.RefreshAuthors(commits)
 
@@ -394,6 +419,130 @@ func (self *RefreshHelper) RefreshAuthors(commits []*models.Commit) {
 	}
 }
 
+// Verifying signatures is slow, so we show the commits without them first and
+// verify the ones that we haven't verified before in the background
+func (self *RefreshHelper) LoadCommitSignatures(commits []*models.Commit, context types.Context) {
+	if !self.c.UserConfig().Gui.ShowCommitSignatures {
+		return
+	}
+
+	self.c.Mutexes().CommitSignaturesMutex.Lock()
+	defer self.c.Mutexes().CommitSignaturesMutex.Unlock()
+
+	// The results of earlier verifications are outdated if the user changed
+	// which signers they trust, or imported or removed keys
+	if key := self.c.Git().Commit.SignatureVerificationKey(); key != self.c.Model().CommitSignaturesKey {
+		self.c.Model().CommitSignatures = map[string]*models.CommitSignature{}
+		self.c.Model().CommitSignaturesKey = key
+	}
+
+	signatures := self.c.Model().CommitSignatures
+	hashesToVerify := []string{}
+	for _, commit := range commits {
+		if commit.IsTODO() {
+			continue
+		}
+		if signature, ok := signatures[commit.Hash()]; ok {
+			commit.Signature = signature
+		} else {
+			hashesToVerify = append(hashesToVerify, commit.Hash())
+		}
+	}
+
+	if len(hashesToVerify) == 0 {
+		return
+	}
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		newSignatures, err := self.c.Git().Commit.GetSignatures(hashesToVerify)
+		if err != nil {
+			self.c.Log.Error(err)
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Mutexes().CommitSignaturesMutex.Lock()
+			defer self.c.Mutexes().CommitSignaturesMutex.Unlock()
+
+			for hash, signature := range newSignatures {
+				signatures[hash] = signature
+			}
+			for _, commit := range commits {
+				if signature, ok := newSignatures[commit.Hash()]; ok {
+					commit.Signature = signature
+				}
+			}
+			context.HandleRender()
+			return nil
+		})
+		return nil
+	})
+}
+
+// Test results are stored by tree hash, so we need to look up the tree hashes
+// of the commits; we do this in the background for the ones that we haven't
+// looked up before
+func (self *RefreshHelper) LoadCommitTestResults(commits []*models.Commit, context types.Context) {
+	self.c.Mutexes().CommitTestsMutex.Lock()
+	defer self.c.Mutexes().CommitTestsMutex.Unlock()
+
+	if self.c.Model().CommitTestResults == nil {
+		results, err := self.c.Git().CommitTesting.LoadResults()
+		if err != nil {
+			self.c.Log.Error(err)
+		}
+		self.c.Model().CommitTestResults = results
+	}
+
+	results := self.c.Model().CommitTestResults
+	if results.LastCommand == "" {
+		return
+	}
+
+	resultsByTree := results.ForCommand(results.LastCommand)
+	treeHashes := self.c.Model().CommitTreeHashes
+	hashesToLookUp := []string{}
+	for _, commit := range commits {
+		if commit.IsTODO() {
+			continue
+		}
+		if treeHash, ok := treeHashes[commit.Hash()]; ok {
+			commit.TestResult = resultsByTree[treeHash]
+		} else {
+			hashesToLookUp = append(hashesToLookUp, commit.Hash())
+		}
+	}
+
+	if len(hashesToLookUp) == 0 || len(resultsByTree) == 0 {
+		return
+	}
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		newTreeHashes, err := self.c.Git().CommitTesting.GetTreeHashes(hashesToLookUp)
+		if err != nil {
+			self.c.Log.Error(err)
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Mutexes().CommitTestsMutex.Lock()
+			defer self.c.Mutexes().CommitTestsMutex.Unlock()
+
+			for hash, treeHash := range newTreeHashes {
+				treeHashes[hash] = treeHash
+			}
+			for _, commit := range commits {
+				if treeHash, ok := newTreeHashes[commit.Hash()]; ok {
+					commit.TestResult = resultsByTree[treeHash]
+				}
+			}
+			context.HandleRender()
+			return nil
+		})
+		return nil
+	})
+}
+
 func (self *RefreshHelper) refreshCommitFilesContext() error {
 	from, to := self.c.Contexts().CommitFiles.GetFromAndToForDiff()
 	from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
@@ -402,6 +551,10 @@ func (self *RefreshHelper) refreshCommitFilesContext() error {
 	if err != nil {
 		return err
 	}
+	lfsPaths := self.c.Git().Lfs.TrackedPaths(lo.Map(files, func(file *models.CommitFile, _ int) string { return file.Path }))
+	for _, file := range files {
+		file.IsLfs = lfsPaths.Includes(file.Path)
+	}
 	self.c.Model().CommitFiles = files
 	self.c.Contexts().CommitFiles.CommitFileTreeViewModel.SetTree()
 
@@ -478,6 +631,8 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 
 	self.c.Model().Branches = branches
 
+	self.loadBranchStack(branches)
+
 	if refreshWorktrees {
 		self.loadWorktrees()
 		self.refreshView(self.c.Contexts().Worktrees)
@@ -504,6 +659,52 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 	self.refreshStatus()
 }
 
+func (self *RefreshHelper) loadBranchStack(branches []*models.Branch) {
+	currentBranch := self.c.Model().CheckedOutBranch
+	if len(branches) > 0 && branches[0].Head && !branches[0].DetachedHead {
+		currentBranch = branches[0].Name
+	}
+
+	generation := self.branchStackGeneration.Add(1)
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		mainBranches := self.c.Model().MainBranches
+		key := self.c.Git().BranchStack.GetStackKey(branches, currentBranch, mainBranches)
+
+		self.branchStackKeyMutex.Lock()
+		unchanged := key != "" && key == self.branchStackKey
+		self.branchStackKeyMutex.Unlock()
+		if unchanged {
+			return nil
+		}
+
+		stack, err := self.c.Git().BranchStack.GetStack(branches, currentBranch, mainBranches)
+		if err != nil {
+			self.c.Log.Error(err)
+			key = ""
+		}
+
+		self.c.OnUIThread(func() error {
+			if generation != self.branchStackGeneration.Load() {
+				// A newer load has started in the meantime
+				return nil
+			}
+
+			self.branchStackKeyMutex.Lock()
+			self.branchStackKey = key
+			self.branchStackKeyMutex.Unlock()
+
+			self.c.Model().BranchStack = stack
+			self.c.Contexts().Branches.HandleRender()
+			self.c.Mutexes().LocalCommitsMutex.Lock()
+			self.c.Contexts().LocalCommits.HandleRender()
+			self.c.Mutexes().LocalCommitsMutex.Unlock()
+			return nil
+		})
+		return nil
+	})
+}
+
 func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	self.c.Mutexes().RefreshingFilesMutex.Lock()
 	self.c.State().SetIsRefreshingFiles(true)
@@ -520,6 +721,8 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 		return err
 	}
 
+	self.refreshPreCommitHooks()
+
 	self.c.OnUIThread(func() error {
 		self.refreshView(self.c.Contexts().Submodules)
 		self.refreshView(self.c.Contexts().StagedFiles)
@@ -530,6 +733,57 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	return nil
 }
 
+// Detecting the pre-commit hooks involves the file system and looking up the
+// pre-commit executable, so we do it once per files refresh rather than each
+// time we check whether they can be run.
+func (self *RefreshHelper) refreshPreCommitHooks() {
+	kind := self.c.Git().Hook.DetectPreCommitHooks()
+	self.c.Model().PreCommitHooks = kind
+	self.c.Model().PreCommitFrameworkInstalled = kind == git_commands.PreCommitFrameworkHooks &&
+		self.c.Git().Hook.IsPreCommitFrameworkInstalled()
+}
+
+// Git doesn't tell us which files rerere resolved, but it does tell us which of
+// the conflicted files it didn't resolve, so we can infer the others. Once these
+// are staged they aren't conflicted anymore, which is why we remember them.
+func (self *RefreshHelper) markRerereResolvedFiles(files []*models.File) {
+	if !self.c.Git().Status.WorkingTreeState().Any() || !self.c.Git().Rerere.IsEnabled() {
+		self.c.Model().RerereResolvedPaths = set.New[string]()
+		return
+	}
+
+	resolvedPaths := self.c.Model().RerereResolvedPaths
+	conflictedFiles := lo.Filter(files, func(file *models.File, _ int) bool { return file.HasInlineMergeConflicts })
+	if len(conflictedFiles) > 0 {
+		remaining, err := self.c.Git().Rerere.Remaining()
+		if err != nil {
+			self.c.Log.Error(err)
+			return
+		}
+
+		newlyResolvedCount := 0
+		for _, file := range conflictedFiles {
+			if lo.Contains(remaining, file.Path) {
+				// e.g. because the user told rerere to forget the resolution
+				resolvedPaths.Remove(file.Path)
+			} else if !resolvedPaths.Includes(file.Path) {
+				resolvedPaths.Add(file.Path)
+				newlyResolvedCount++
+			}
+		}
+
+		if newlyResolvedCount > 0 {
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.RerereResolvedFilesToast, map[string]string{
+				"count": strconv.Itoa(newlyResolvedCount),
+			}))
+		}
+	}
+
+	for _, file := range files {
+		file.IsRerereResolved = resolvedPaths.Includes(file.Path)
+	}
+}
+
 func (self *RefreshHelper) refreshStateFiles() error {
 	fileTreeViewModel := self.c.Contexts().Files.FileTreeViewModel
 
@@ -569,6 +823,11 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		GetStatusFiles(git_commands.GetStatusFileOptions{
 			ForceShowUntracked: self.c.Contexts().Files.ForceShowUntracked(),
 		})
+	lfsPaths := self.c.Git().Lfs.TrackedPaths(lo.Map(files, func(file *models.File, _ int) string { return file.Path }))
+	for _, file := range files {
+		file.IsLfs = lfsPaths.Includes(file.Path)
+	}
+	self.markRerereResolvedFiles(files)
 
 	conflictFileCount := 0
 	for _, file := range files {
@@ -733,6 +992,11 @@ func (self *RefreshHelper) refForLog() string {
 	self.c.Model().BisectInfo = bisectInfo
 
 	if !bisectInfo.Started() {
+		// git log -L interprets the line range against the revision that it
+		// starts from
+		if revision := self.c.Modes().Filtering.GetLineRangeRevision(); revision != "" {
+			return revision
+		}
 		return "HEAD"
 	}
 
@@ -0,0 +1,201 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Untracked files at least this large are probably not meant to be committed
+const largeUntrackedFileSize = 10 * 1024 * 1024
+
+type RepoHealthHelper struct {
+	c *HelperCommon
+}
+
+func NewRepoHealthHelper(c *HelperCommon) *RepoHealthHelper {
+	return &RepoHealthHelper{
+		c: c,
+	}
+}
+
+// Render shows the repository health dashboard in the main view. Collecting
+// the statistics can take a moment in large repos, so we do that in the
+// background.
+func (self *RepoHealthHelper) Render() {
+	self.renderString(self.c.Tr.CheckingRepoHealth)
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		health := self.load()
+		self.c.OnUIThread(func() error {
+			// Don't overwrite the main view if the user moved on in the meantime
+			if self.c.Context().CurrentSide() != self.c.Contexts().Status {
+				return nil
+			}
+
+			maintenanceKey := keybindings.Label(self.c.UserConfig().Keybinding.Status.RepoMaintenance)
+			self.renderString(presentation.GetRepoHealthDashboard(health, maintenanceKey, self.c.Tr))
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *RepoHealthHelper) renderString(str string) {
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: self.c.Tr.RepoHealthTitle,
+			Task:  types.NewRenderStringTask(str),
+		},
+	})
+}
+
+func (self *RepoHealthHelper) load() *models.RepoHealth {
+	health := &models.RepoHealth{
+		HasCommitGraph:        self.c.Git().Maintenance.HasCommitGraph(),
+		MaintenanceRegistered: self.c.Git().Maintenance.IsRegistered(),
+		StaleWorktrees: lo.Filter(self.c.Model().Worktrees, func(worktree *models.Worktree, _ int) bool {
+			return worktree.IsPathMissing
+		}),
+		GoneUpstreamBranches: lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+			return branch.UpstreamGone
+		}),
+	}
+
+	if objects, err := self.c.Git().Maintenance.CountObjects(); err == nil {
+		health.Objects = objects
+	} else {
+		self.c.Log.Error(err)
+	}
+
+	worktreePath := self.c.Git().RepoPaths.WorktreePath()
+	for _, file := range self.c.Model().Files {
+		if file.Tracked {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(worktreePath, file.Path))
+		if err == nil && info.Mode().IsRegular() && info.Size() >= largeUntrackedFileSize {
+			health.LargeUntrackedFiles = append(health.LargeUntrackedFiles, &models.LargeFile{Path: file.Path, Size: info.Size()})
+		}
+	}
+
+	return health
+}
+
+func (self *RepoHealthHelper) OpenMaintenanceMenu() error {
+	taskKeys := map[string]types.Key{
+		"commit-graph":       'c',
+		"prefetch":           'f',
+		"loose-objects":      'l',
+		"incremental-repack": 'i',
+		"pack-refs":          'r',
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: self.c.Tr.MaintenanceGc,
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.Gc, self.c.Git().Maintenance.Gc, nil)
+			},
+			Key: 'g',
+		},
+	}
+
+	for _, task := range git_commands.MaintenanceTasks {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.MaintenanceRunTask, map[string]string{
+				"task": task,
+			}),
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.RunMaintenanceTask, func() error {
+					return self.c.Git().Maintenance.RunTask(task)
+				}, nil)
+			},
+			Key: taskKeys[task],
+		})
+	}
+
+	menuItems = append(menuItems,
+		&types.MenuItem{
+			Label: self.c.Tr.MaintenancePruneWorktrees,
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.PruneWorktrees, self.c.Git().Maintenance.PruneWorktrees,
+					[]types.RefreshableView{types.WORKTREES})
+			},
+			Key: 'w',
+		},
+		&types.MenuItem{
+			Label: self.c.Tr.MaintenancePruneRemotes,
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.PruneRemote, self.pruneRemotes,
+					[]types.RefreshableView{types.REMOTES, types.BRANCHES})
+			},
+			Key: 'p',
+		},
+	)
+
+	if self.c.Git().Maintenance.IsRegistered() {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.MaintenanceUnregister,
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.UnregisterMaintenance, self.c.Git().Maintenance.Unregister, nil)
+			},
+			Key: 'm',
+		})
+	} else {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.MaintenanceRegister,
+			OnPress: func() error {
+				return self.run(self.c.Tr.Actions.RegisterMaintenance, self.c.Git().Maintenance.Register, nil)
+			},
+			Key: 'm',
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RepoMaintenance,
+		Items: menuItems,
+	})
+}
+
+func (self *RepoHealthHelper) pruneRemotes() error {
+	for _, remote := range self.c.Model().Remotes {
+		if err := self.c.Git().Maintenance.PruneRemote(remote.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Runs a maintenance command, whose output goes to the command log, and then
+// shows the updated health dashboard if it's still visible
+func (self *RepoHealthHelper) run(action string, f func() error, scope []types.RefreshableView) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RunningMaintenanceStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+		if err := f(); err != nil {
+			return err
+		}
+
+		if len(scope) > 0 {
+			self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: scope})
+		}
+
+		self.c.OnUIThread(func() error {
+			if self.c.Views().Main.Title == self.c.Tr.RepoHealthTitle &&
+				self.c.Context().CurrentSide() == self.c.Contexts().Status {
+				self.Render()
+			}
+			return nil
+		})
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type RerereHelper struct {
+	c *HelperCommon
+}
+
+func NewRerereHelper(c *HelperCommon) *RerereHelper {
+	return &RerereHelper{
+		c: c,
+	}
+}
+
+// OpenMenu shows the rerere options for the given file, which is nil if no
+// file is selected
+func (self *RerereHelper) OpenMenu(file *models.File) error {
+	forgetItem := &types.MenuItem{
+		Label:   self.c.Tr.RerereForget,
+		Key:     'f',
+		Tooltip: self.c.Tr.RerereForgetTooltip,
+	}
+	if file == nil || !file.IsRerereResolved {
+		forgetItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.RerereFileNotResolvedByRerere}
+	} else {
+		forgetItem.OnPress = func() error { return self.confirmForget(file.Path) }
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewRerereOptions,
+		Items: []*types.MenuItem{
+			forgetItem,
+			{
+				Label:   self.c.Tr.RerereViewResolutions,
+				OnPress: self.ViewResolutions,
+				Key:     'v',
+				Tooltip: self.c.Tr.RerereViewResolutionsTooltip,
+			},
+		},
+	})
+}
+
+func (self *RerereHelper) confirmForget(path string) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RerereForget,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RerereForgetPrompt, map[string]string{
+			"path": path,
+		}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RerereForget)
+			if err := self.c.Git().Rerere.Forget(path); err != nil {
+				return err
+			}
+			// Forgetting the resolution doesn't undo it, so bring back the
+			// conflict markers to let the user resolve the file again
+			if err := self.c.Git().Rerere.RecreateConflict(path); err != nil {
+				return err
+			}
+
+			self.c.Model().RerereResolvedPaths.Remove(path)
+			self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+// ViewResolutions shows the conflicts that rerere recorded in a list in the
+// files window
+func (self *RerereHelper) ViewResolutions() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingRerereResolutionsStatus, func(gocui.Task) error {
+		if err := self.ReloadResolutions(); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			context := self.c.Contexts().RerereResolutions
+			context.SetSelection(0)
+			context.SetParentContext(self.c.Contexts().Files)
+			self.c.PostRefreshUpdate(context)
+			self.c.Context().Push(context, types.OnFocusOpts{})
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *RerereHelper) ReloadResolutions() error {
+	resolutions, err := self.c.Git().Rerere.GetResolutions()
+	if err != nil {
+		return err
+	}
+
+	self.c.Model().RerereResolutions = resolutions
+	return nil
+}
@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type SparseCheckoutHelper struct {
+	c *HelperCommon
+}
+
+func NewSparseCheckoutHelper(c *HelperCommon) *SparseCheckoutHelper {
+	return &SparseCheckoutHelper{
+		c: c,
+	}
+}
+
+func (self *SparseCheckoutHelper) OpenMenu() error {
+	if !self.c.Git().SparseCheckout.IsEnabled() {
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: self.c.Tr.ViewSparseCheckoutOptions,
+			Items: []*types.MenuItem{
+				{
+					Label:   self.c.Tr.SparseCheckoutEnableConeMode,
+					OnPress: func() error { return self.enable(true) },
+					Key:     'c',
+					Tooltip: self.c.Tr.SparseCheckoutEnableConeModeTooltip,
+				},
+				{
+					Label:   self.c.Tr.SparseCheckoutEnableNonConeMode,
+					OnPress: func() error { return self.enable(false) },
+					Key:     'n',
+					Tooltip: self.c.Tr.SparseCheckoutEnableNonConeModeTooltip,
+				},
+			},
+		})
+	}
+
+	patterns, err := self.c.Git().SparseCheckout.List()
+	if err != nil {
+		return err
+	}
+
+	cone := self.c.Git().SparseCheckout.IsConeMode()
+	title := self.c.Tr.SparseCheckoutNonConeModeTitle
+	patternsSection := &types.MenuSection{Title: self.c.Tr.SparseCheckoutPatterns}
+	if cone {
+		title = self.c.Tr.SparseCheckoutConeModeTitle
+		patternsSection = &types.MenuSection{Title: self.c.Tr.SparseCheckoutDirectories}
+	}
+
+	menuItems := lo.Map(patterns, func(pattern string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:   pattern,
+			OnPress: func() error { return self.confirmRemove(pattern) },
+			Tooltip: self.c.Tr.SparseCheckoutRemoveTooltip,
+			Section: patternsSection,
+		}
+	})
+	prompt := ""
+	if len(menuItems) == 0 {
+		prompt = self.c.Tr.SparseCheckoutOnlyTopLevelFiles
+	}
+
+	switchModeItem := &types.MenuItem{
+		Label:   self.c.Tr.SparseCheckoutSwitchToConeMode,
+		OnPress: func() error { return self.setConeMode(true) },
+		Key:     'c',
+		Tooltip: self.c.Tr.SparseCheckoutSwitchToConeModeTooltip,
+	}
+	if cone {
+		switchModeItem = &types.MenuItem{
+			Label:   self.c.Tr.SparseCheckoutSwitchToNonConeMode,
+			OnPress: func() error { return self.setConeMode(false) },
+			Key:     'c',
+			Tooltip: self.c.Tr.SparseCheckoutSwitchToNonConeModeTooltip,
+		}
+	}
+
+	actionsSection := &types.MenuSection{Title: self.c.Tr.SparseCheckoutActions}
+	actionItems := []*types.MenuItem{
+		{
+			Label:     self.c.Tr.SparseCheckoutPickDirectories,
+			OnPress:   func() error { return self.pickDirectories(patterns, cone) },
+			Key:       'a',
+			Tooltip:   self.c.Tr.SparseCheckoutPickDirectoriesTooltip,
+			OpensMenu: true,
+		},
+		switchModeItem,
+		{
+			Label:   self.c.Tr.SparseCheckoutDisable,
+			OnPress: self.disable,
+			Key:     'd',
+			Tooltip: self.c.Tr.SparseCheckoutDisableTooltip,
+		},
+	}
+	for _, item := range actionItems {
+		item.Section = actionsSection
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  title,
+		Prompt: prompt,
+		Items:  append(menuItems, actionItems...),
+	})
+}
+
+// Shows the directories of the repo as a tree, built from all tracked files so
+// that we can tell which directories are (partially) checked out by looking at
+// their files' skip-worktree bits
+func (self *SparseCheckoutHelper) pickDirectories(patterns []string, cone bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingTrackedFilesStatus, func(gocui.Task) error {
+		files, err := self.c.Git().Loaders.FileLoader.GetTrackedFiles()
+		if err != nil {
+			return err
+		}
+
+		// Every directory must be pickable, so we don't compress directories
+		// with a single child directory into one node
+		root := filetree.BuildUncompressedTreeFromFiles(files, false)
+		menuItems := []*types.MenuItem{}
+		var addDirectoryItems func(node *filetree.Node[models.File], parentPath string, depth int)
+		addDirectoryItems = func(node *filetree.Node[models.File], parentPath string, depth int) {
+			for _, child := range node.Children {
+				if child.IsFile() {
+					continue
+				}
+
+				menuItems = append(menuItems, self.directoryMenuItem(child, parentPath, depth, patterns, cone))
+				addDirectoryItems(child, child.GetPath(), depth+1)
+			}
+		}
+		addDirectoryItems(root, "", 0)
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: self.c.Tr.SparseCheckoutPickDirectoryTitle,
+				Items: menuItems,
+			})
+		})
+		return nil
+	})
+}
+
+func (self *SparseCheckoutHelper) directoryMenuItem(
+	node *filetree.Node[models.File],
+	parentPath string,
+	depth int,
+	patterns []string,
+	cone bool,
+) *types.MenuItem {
+	dir := node.GetPath()
+	// In non-cone mode, this is the pattern that matches the directory and
+	// everything in it
+	pattern := dir
+	if !cone {
+		pattern = "/" + dir + "/"
+	}
+	isSelected := lo.Contains(patterns, pattern)
+
+	var disabledReason *types.DisabledReason
+	if cone && !isSelected {
+		if parentDir, ok := lo.Find(patterns, func(p string) bool {
+			return strings.HasPrefix(dir, p+"/")
+		}); ok {
+			disabledReason = &types.DisabledReason{
+				Text: utils.ResolvePlaceholderString(self.c.Tr.SparseCheckoutIncludedByParent, map[string]string{
+					"dir": parentDir,
+				}),
+			}
+		}
+	}
+
+	return &types.MenuItem{
+		LabelColumns: presentation.GetSparseCheckoutDirectoryColumns(node, parentPath, depth, self.c.Tr),
+		Widget:       types.MakeMenuCheckBox(isSelected),
+		OnPress: func() error {
+			if isSelected {
+				return self.remove(pattern)
+			}
+			return self.add(pattern)
+		},
+		DisabledReason: disabledReason,
+	}
+}
+
+func (self *SparseCheckoutHelper) confirmRemove(pattern string) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.SparseCheckoutRemoveTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.SparseCheckoutRemovePrompt, map[string]string{
+			"pattern": pattern,
+		}),
+		HandleConfirm: func() error {
+			return self.remove(pattern)
+		},
+	})
+
+	return nil
+}
+
+func (self *SparseCheckoutHelper) add(pattern string) error {
+	return self.update(self.c.Tr.Actions.AddToSparseCheckout, func() error {
+		return self.c.Git().SparseCheckout.Add([]string{pattern})
+	})
+}
+
+func (self *SparseCheckoutHelper) remove(pattern string) error {
+	return self.update(self.c.Tr.Actions.RemoveFromSparseCheckout, func() error {
+		return self.c.Git().SparseCheckout.Remove([]string{pattern})
+	})
+}
+
+func (self *SparseCheckoutHelper) enable(cone bool) error {
+	return self.update(self.c.Tr.Actions.EnableSparseCheckout, func() error {
+		return self.c.Git().SparseCheckout.Enable(cone)
+	})
+}
+
+func (self *SparseCheckoutHelper) disable() error {
+	return self.update(self.c.Tr.Actions.DisableSparseCheckout, self.c.Git().SparseCheckout.Disable)
+}
+
+func (self *SparseCheckoutHelper) setConeMode(cone bool) error {
+	return self.update(self.c.Tr.Actions.SetSparseCheckoutMode, func() error {
+		return self.c.Git().SparseCheckout.SetConeMode(cone)
+	})
+}
+
+// Changing the sparse checkout adds or removes files from the working tree,
+// which can take a while in a big repo
+func (self *SparseCheckoutHelper) update(action string, f func() error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+		err := f()
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+		return err
+	})
+}
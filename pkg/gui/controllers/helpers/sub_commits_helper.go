@@ -37,18 +37,25 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 			Limit:                   true,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterLineRange:         self.c.Modes().Filtering.GetLineRangeArg(),
+			FilterPickaxe:           self.c.Modes().Filtering.GetPickaxe(),
+			FilterPickaxeIsRegex:    self.c.Modes().Filtering.PickaxeIsRegex(),
+			FilterMessage:           self.c.Modes().Filtering.GetMessagePattern(),
 			IncludeRebaseCommits:    false,
 			RefName:                 opts.Ref.FullRefName(),
 			RefForPushedStatus:      opts.Ref,
 			RefToShowDivergenceFrom: opts.RefToShowDivergenceFrom,
 			MainBranches:            self.c.Model().MainBranches,
 			HashPool:                self.c.Model().HashPool,
+			NotesRefs:               self.c.Git().Notes.NotesRefs(),
 		},
 	)
 	if err != nil {
 		return err
 	}
 
+	self.refreshHelper.LoadCommitSignatures(commits, self.c.Contexts().SubCommits)
+	self.refreshHelper.LoadCommitTestResults(commits, self.c.Contexts().SubCommits)
 	self.setSubCommits(commits)
 	self.refreshHelper.RefreshAuthors(commits)
 
@@ -0,0 +1,170 @@
+package helpers
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+var trailerKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+// A function that applies a change to the trailers of a commit message
+type TrailersEdit func([]git_commands.Trailer) []git_commands.Trailer
+
+type TrailersHelper struct {
+	c                 *HelperCommon
+	suggestionsHelper *SuggestionsHelper
+}
+
+func NewTrailersHelper(c *HelperCommon, suggestionsHelper *SuggestionsHelper) *TrailersHelper {
+	return &TrailersHelper{
+		c:                 c,
+		suggestionsHelper: suggestionsHelper,
+	}
+}
+
+// OpenTrailersMenu shows a menu for adding a trailer, and for editing or
+// removing any of the given ones. The chosen change is passed to onEdit.
+func (self *TrailersHelper) OpenTrailersMenu(trailers []git_commands.Trailer, onEdit func(TrailersEdit) error) error {
+	menuItems := []*types.MenuItem{
+		{
+			Label:     self.c.Tr.AddTrailer,
+			OnPress:   func() error { return self.openAddTrailerMenu(onEdit) },
+			Key:       'a',
+			OpensMenu: true,
+		},
+	}
+
+	for _, trailer := range lo.Uniq(trailers) {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:     trailer.String(),
+			OnPress:   func() error { return self.openTrailerMenu(trailer, onEdit) },
+			OpensMenu: true,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.TrailersMenuTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *TrailersHelper) openAddTrailerMenu(onEdit func(TrailersEdit) error) error {
+	addTrailer := func(key string) error {
+		return self.promptForValue(key, "", func(value string) error {
+			return onEdit(AddTrailer(git_commands.Trailer{Key: key, Value: value}))
+		})
+	}
+
+	menuItems := lo.Map(self.c.UserConfig().Git.Commit.TrailerKeys, func(key string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:   key,
+			OnPress: func() error { return addTrailer(key) },
+		}
+	})
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.OtherTrailerKey,
+		OnPress: func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.TrailerKeyPromptTitle,
+				HandleConfirm: func(key string) error {
+					key = strings.TrimSuffix(strings.TrimSpace(key), ":")
+					if !trailerKeyRegexp.MatchString(key) {
+						return errors.New(self.c.Tr.InvalidTrailerKey)
+					}
+					return addTrailer(key)
+				},
+			})
+			return nil
+		},
+		Key: 'o',
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AddTrailer,
+		Items: menuItems,
+	})
+}
+
+func (self *TrailersHelper) openTrailerMenu(trailer git_commands.Trailer, onEdit func(TrailersEdit) error) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: trailer.String(),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.EditTrailerValue,
+				OnPress: func() error {
+					return self.promptForValue(trailer.Key, trailer.Value, func(value string) error {
+						return onEdit(ReplaceTrailer(trailer, git_commands.Trailer{Key: trailer.Key, Value: value}))
+					})
+				},
+				Key: 'e',
+			},
+			{
+				Label:   self.c.Tr.RemoveTrailer,
+				OnPress: func() error { return onEdit(RemoveTrailer(trailer)) },
+				Key:     'd',
+			},
+		},
+	})
+}
+
+func (self *TrailersHelper) promptForValue(key string, initialValue string, onConfirm func(string) error) error {
+	var findSuggestionsFunc func(string) []*types.Suggestion
+	// Keys like Reviewed-by or Signed-off-by name a person
+	if strings.HasSuffix(strings.ToLower(key), "-by") {
+		findSuggestionsFunc = self.suggestionsHelper.GetAuthorsSuggestionsFunc()
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               utils.ResolvePlaceholderString(self.c.Tr.TrailerValuePromptTitle, map[string]string{"key": key}),
+		InitialContent:      initialValue,
+		FindSuggestionsFunc: findSuggestionsFunc,
+		HandleConfirm: func(value string) error {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return errors.New(self.c.Tr.TrailerValueMustNotBeEmpty)
+			}
+			return onConfirm(value)
+		},
+	})
+
+	return nil
+}
+
+// AddTrailer returns an edit that appends the given trailer, unless it is
+// already there
+func AddTrailer(trailer git_commands.Trailer) TrailersEdit {
+	return func(trailers []git_commands.Trailer) []git_commands.Trailer {
+		if slices.Contains(trailers, trailer) {
+			return trailers
+		}
+		return append(slices.Clone(trailers), trailer)
+	}
+}
+
+// RemoveTrailer returns an edit that removes all occurrences of the given
+// trailer
+func RemoveTrailer(trailer git_commands.Trailer) TrailersEdit {
+	return func(trailers []git_commands.Trailer) []git_commands.Trailer {
+		return lo.Without(trailers, trailer)
+	}
+}
+
+// ReplaceTrailer returns an edit that replaces all occurrences of oldTrailer
+// with newTrailer
+func ReplaceTrailer(oldTrailer git_commands.Trailer, newTrailer git_commands.Trailer) TrailersEdit {
+	return func(trailers []git_commands.Trailer) []git_commands.Trailer {
+		return lo.Uniq(lo.Map(trailers, func(trailer git_commands.Trailer, _ int) git_commands.Trailer {
+			if trailer == oldTrailer {
+				return newTrailer
+			}
+			return trailer
+		}))
+	}
+}
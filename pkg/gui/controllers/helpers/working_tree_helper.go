@@ -2,14 +2,11 @@ package helpers
 
 import (
 	"errors"
-	"fmt"
 	"os"
-	"regexp"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
-	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -22,6 +19,8 @@ type WorkingTreeHelper struct {
 	commitsHelper        *CommitsHelper
 	gpgHelper            *GpgHelper
 	mergeAndRebaseHelper *MergeAndRebaseHelper
+	generatorHelper      *CommitGeneratorHelper
+	lintHelper           *CommitLintHelper
 }
 
 func NewWorkingTreeHelper(
@@ -30,6 +29,8 @@ func NewWorkingTreeHelper(
 	commitsHelper *CommitsHelper,
 	gpgHelper *GpgHelper,
 	mergeAndRebaseHelper *MergeAndRebaseHelper,
+	generatorHelper *CommitGeneratorHelper,
+	lintHelper *CommitLintHelper,
 ) *WorkingTreeHelper {
 	return &WorkingTreeHelper{
 		c:                    c,
@@ -37,6 +38,8 @@ func NewWorkingTreeHelper(
 		commitsHelper:        commitsHelper,
 		gpgHelper:            gpgHelper,
 		mergeAndRebaseHelper: mergeAndRebaseHelper,
+		generatorHelper:      generatorHelper,
+		lintHelper:           lintHelper,
 	}
 }
 
@@ -124,11 +127,15 @@ func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage strin
 				DescriptionTitle: self.c.Tr.CommitDescriptionTitle,
 				PreserveMessage:  true,
 				OnConfirm: func(summary string, description string) error {
-					return self.handleCommit(summary, description, forceSkipHooks)
+					return self.lintHelper.WithLintCheck(summary, description, func() error {
+						return self.handleCommit(summary, description, forceSkipHooks, nil, nil)
+					})
 				},
 				OnSwitchToEditor: func(filepath string) error {
 					return self.switchFromCommitMessagePanelToEditor(filepath, forceSkipHooks)
 				},
+				OnGenerate:      self.generatorHelper.GenerateIntoCommitMessagePanel,
+				LintMessage:     self.lintHelper.ViolationsSubtitle,
 				ForceSkipHooks:  forceSkipHooks,
 				SkipHooksPrefix: self.c.UserConfig().Git.SkipHookPrefix,
 			},
@@ -141,8 +148,16 @@ func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage strin
 // CommitStagedWithMessage commits staged changes using the provided message,
 // without opening the commit message panel. The onSuccess callback runs only
 // after a successful commit (and only after any "stage all" confirmation has
-// been accepted).
+// been accepted). Unless the commit needs a gpg subprocess, the commit runs in
+// the background, and so does onSuccess.
 func (self *WorkingTreeHelper) CommitStagedWithMessage(message string, forceSkipHooks bool, onSuccess func() error) error {
+	return self.CommitStagedWithMessageAndFailureHandler(message, forceSkipHooks, onSuccess, nil)
+}
+
+// CommitStagedWithMessageAndFailureHandler is like CommitStagedWithMessage, but
+// if the commit fails (e.g. because a hook rejected it) and onFailure is not
+// nil, it is called with the error instead of showing the error to the user.
+func (self *WorkingTreeHelper) CommitStagedWithMessageAndFailureHandler(message string, forceSkipHooks bool, onSuccess func() error, onFailure func(error) error) error {
 	commitMessage := strings.TrimSpace(message)
 	if commitMessage == "" {
 		return errors.New(self.c.Tr.CommitWithoutMessageErr)
@@ -159,25 +174,46 @@ func (self *WorkingTreeHelper) CommitStagedWithMessage(message string, forceSkip
 		return errors.New(self.c.Tr.CommitWithoutMessageErr)
 	}
 
-	return self.WithEnsureCommittableFiles(func() error {
-		if err := self.handleCommit(summary, description, forceSkipHooks); err != nil {
-			return err
-		}
-		if onSuccess != nil {
-			return onSuccess()
-		}
+	return self.lintHelper.WithLintCheck(summary, description, func() error {
+		return self.WithEnsureCommittableFiles(func() error {
+			return self.handleCommit(summary, description, forceSkipHooks, onSuccess, onFailure)
+		})
+	})
+}
+
+// ClearCommitInput empties the inline commit message input, e.g. after a
+// successful commit. It can be called from a background goroutine.
+func (self *WorkingTreeHelper) ClearCommitInput() {
+	self.c.OnUIThread(func() error {
+		view := self.c.Views().CommitInput
+		view.ClearTextArea()
+		view.RenderTextArea()
+		self.lintHelper.RenderCommitInputViolations()
 		return nil
 	})
 }
 
-func (self *WorkingTreeHelper) handleCommit(summary string, description string, forceSkipHooks bool) error {
+// SetCommitInputContent replaces the message in the inline commit message
+// input. Must be called on the UI thread.
+func (self *WorkingTreeHelper) SetCommitInputContent(message string) {
+	view := self.c.Views().CommitInput
+	view.ClearTextArea()
+	view.TextArea.TypeString(message)
+	view.RenderTextArea()
+	self.lintHelper.RenderCommitInputViolations()
+}
+
+func (self *WorkingTreeHelper) handleCommit(summary string, description string, forceSkipHooks bool, onSuccess func() error, onFailure func(error) error) error {
 	cmdObj := self.c.Git().Commit.CommitCmdObj(summary, description, forceSkipHooks)
 	self.c.LogAction(self.c.Tr.Actions.Commit)
-	return self.gpgHelper.WithGpgHandling(cmdObj, git_commands.CommitGpgSign, self.c.Tr.CommittingStatus,
+	return self.gpgHelper.WithGpgHandlingAndFailureHandler(cmdObj, git_commands.CommitGpgSign, self.c.Tr.CommittingStatus,
 		func() error {
 			self.commitsHelper.ClearPreservedCommitMessage()
+			if onSuccess != nil {
+				return onSuccess()
+			}
 			return nil
-		}, nil)
+		}, onFailure, nil)
 }
 
 func (self *WorkingTreeHelper) switchFromCommitMessagePanelToEditor(filepath string, forceSkipHooks bool) error {
@@ -224,25 +260,11 @@ func (self *WorkingTreeHelper) HandleCommitPress() error {
 	message := self.c.Contexts().CommitMessage.GetPreservedMessageAndLogError()
 
 	if message == "" {
-		commitPrefixConfigs := self.commitPrefixConfigsForRepo()
-		for _, commitPrefixConfig := range commitPrefixConfigs {
-			prefixPattern := commitPrefixConfig.Pattern
-			if prefixPattern == "" {
-				continue
-			}
-			prefixReplace := commitPrefixConfig.Replace
-			branchName := self.refHelper.GetCheckedOutRef().Name
-			rgx, err := regexp.Compile(prefixPattern)
-			if err != nil {
-				return fmt.Errorf("%s: %s", self.c.Tr.CommitPrefixPatternError, err.Error())
-			}
-
-			if rgx.MatchString(branchName) {
-				prefix := rgx.ReplaceAllString(branchName, prefixReplace)
-				message = prefix
-				break
-			}
+		prefix, err := self.commitsHelper.CommitPrefixForBranch(self.refHelper.GetCheckedOutRef().Name)
+		if err != nil {
+			return err
 		}
+		message = prefix
 	}
 
 	return self.HandleCommitPressWithMessage(message, false)
@@ -302,15 +324,6 @@ func (self *WorkingTreeHelper) prepareFilesForCommit() error {
 	return nil
 }
 
-func (self *WorkingTreeHelper) commitPrefixConfigsForRepo() []config.CommitPrefixConfig {
-	cfg, ok := self.c.UserConfig().Git.CommitPrefixes[self.c.Git().RepoPaths.RepoName()]
-	if ok {
-		return append(cfg, self.c.UserConfig().Git.CommitPrefix...)
-	}
-
-	return self.c.UserConfig().Git.CommitPrefix
-}
-
 func (self *WorkingTreeHelper) mergeFile(filepath string, strategy string) (string, error) {
 	if self.c.Git().Version.IsOlderThan(2, 43, 0) {
 		return self.mergeFileWithTempFiles(filepath, strategy)
@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
@@ -110,6 +112,15 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			),
 			Description: self.c.Tr.RewordCommitEditor,
 		},
+		{
+			Key:     opts.GetKey(opts.Config.Commits.RegenerateCommitMessage),
+			Handler: opts.Guards.OutsideFilterMode(self.withItemsRange(self.regenerateMessages)),
+			GetDisabledReason: self.require(
+				self.itemRangeSelected(self.canRegenerateMessages),
+			),
+			Description: self.c.Tr.RegenerateCommitMessage,
+			Tooltip:     self.c.Tr.RegenerateCommitMessageTooltip,
+		},
 		{
 			Key:     opts.GetKey(opts.Config.Universal.Remove),
 			Handler: self.withItemsRange(self.drop),
@@ -246,6 +257,44 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description:       self.c.Tr.TagCommit,
 			Tooltip:           self.c.Tr.TagCommitTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewNotesOptions),
+			Handler:           self.withItem(self.openNotesMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canEditNotes)),
+			Description:       self.c.Tr.ViewNotesOptions,
+			Tooltip:           self.c.Tr.ViewNotesOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.FetchLfsObjects),
+			Handler:           self.withItemsRange(self.fetchLfsObjects),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
+			Description:       self.c.Tr.FetchLfsObjects,
+			Tooltip:           self.c.Tr.FetchLfsObjectsTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ViewRangeDiffOptions),
+			Handler:     self.openRangeDiffMenu,
+			Description: self.c.Tr.ViewRangeDiffOptions,
+			Tooltip:     self.c.Tr.ViewRangeDiffOptionsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewStackOptions),
+			Handler:           self.withItem(self.openBranchStackMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(), self.c.Helpers().BranchStack.GetDisabledReason),
+			Description:       self.c.Tr.ViewStackOptions,
+			Tooltip:           self.c.Tr.ViewStackOptionsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ExportPatches),
+			Handler:           self.withItemsRange(self.exportPatches),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.canExportPatches)),
+			Description:       self.c.Tr.ExportPatchSeries,
+			Tooltip:           self.c.Tr.ExportPatchSeriesTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.OpenLogMenu),
 			Handler:     self.handleOpenLogMenu,
@@ -275,11 +324,28 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 			} else if commit.Action == todo.Exec {
 				task = types.NewRenderStringTask(
 					self.c.Tr.ExecCommandHere + "\n\n" + commit.Name)
+			} else if commit.IsTODO() && commit.Hash() == "" &&
+				(commit.Action == todo.Label || commit.Action == todo.Reset || commit.Action == todo.Merge) {
+				template := map[todo.TodoCommand]string{
+					todo.Label: self.c.Tr.LabelTodoHere,
+					todo.Reset: self.c.Tr.ResetTodoHere,
+					todo.Merge: self.c.Tr.MergeTodoHere,
+				}[commit.Action]
+				task = types.NewRenderStringTask(
+					utils.ResolvePlaceholderString(template, map[string]string{"label": commit.Name}))
 			} else {
 				refRange := self.context().GetSelectedRefRangeForDiffFiles()
 				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
 			}
 
+			secondary := secondaryPatchPanelUpdateOpts(self.c)
+			if secondary == nil && commit != nil && commit.TestResult != nil && commit.TestResult.Failed() {
+				secondary = &types.ViewUpdateOpts{
+					Task:  types.NewRenderStringTask(commit.TestResult.Output),
+					Title: self.c.Tr.CommitTestOutputTitle,
+				}
+			}
+
 			self.c.RenderToMainViews(types.RefreshMainOpts{
 				Pair: self.c.MainViewPairs().Normal,
 				Main: &types.ViewUpdateOpts{
@@ -287,7 +353,7 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Task:     task,
 				},
-				Secondary: secondaryPatchPanelUpdateOpts(self.c),
+				Secondary: secondary,
 			})
 		})
 	}
@@ -496,6 +562,144 @@ func (self *LocalCommitsController) rewordEditor(commit *models.Commit) error {
 		})
 }
 
+type regeneratedCommitMessage struct {
+	commit     *models.Commit
+	oldMessage string
+	newMessage helpers.GeneratedCommitMessage
+}
+
+func (self *LocalCommitsController) regenerateMessages(selectedCommits []*models.Commit, _, _ int) error {
+	return self.c.Helpers().CommitGenerator.WithGenerator(func(generator config.CommitGeneratorCommand) error {
+		generatorHelper := self.c.Helpers().CommitGenerator
+		statusText := generatorHelper.GeneratingStatus(self.c.Tr.GeneratingCommitMessagesStatus)
+		return generatorHelper.RunGeneration(statusText, func(task *helpers.CommitGenerationTask) error {
+			// Go from the oldest commit to the newest, which is the order in
+			// which the user will want to review them
+			results := make([]regeneratedCommitMessage, 0, len(selectedCommits))
+			for i := len(selectedCommits) - 1; i >= 0; i-- {
+				commit := selectedCommits[i]
+				oldMessage, err := self.c.Git().Commit.GetCommitMessage(commit.Hash())
+				if err != nil {
+					return err
+				}
+				input, err := generatorHelper.CommitInput(commit)
+				if err != nil {
+					return err
+				}
+				newMessage, err := generatorHelper.Generate(task, generator, input, func(string) {})
+				if errors.Is(err, helpers.ErrCommitGenerationCancelled) {
+					self.c.OnUIThread(func() error {
+						self.c.Toast(self.c.Tr.CommitGenerationCancelled)
+						return nil
+					})
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				results = append(results, regeneratedCommitMessage{
+					commit:     commit,
+					oldMessage: oldMessage,
+					newMessage: generatorHelper.FormatGeneratedMessage(newMessage),
+				})
+			}
+
+			self.c.OnUIThread(func() error {
+				return self.confirmRegeneratedMessages(results, 0, map[string]git_commands.CommitMessage{})
+			})
+			return nil
+		})
+	})
+}
+
+// Asks the user about one regenerated message after the other, and rewords
+// the accepted ones once all have been answered.
+func (self *LocalCommitsController) confirmRegeneratedMessages(
+	results []regeneratedCommitMessage,
+	index int,
+	accepted map[string]git_commands.CommitMessage,
+) error {
+	if index == len(results) {
+		return self.rewordWithRegeneratedMessages(accepted)
+	}
+
+	result := results[index]
+	next := func() error {
+		return self.confirmRegeneratedMessages(results, index+1, accepted)
+	}
+	newMessage := result.newMessage.Summary
+	if result.newMessage.Description != "" {
+		newMessage += "\n\n" + result.newMessage.Description
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.RegeneratedCommitMessageTitle, map[string]string{
+			"index": strconv.Itoa(index + 1),
+			"count": strconv.Itoa(len(results)),
+		}),
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RegeneratedCommitMessagePrompt, map[string]string{
+			"hash":       result.commit.ShortHash(),
+			"before":     strings.TrimSpace(result.oldMessage),
+			"after":      newMessage,
+			"confirmKey": keybindings.Label(self.c.UserConfig().Keybinding.Universal.Confirm),
+			"cancelKey":  keybindings.Label(self.c.UserConfig().Keybinding.Universal.Return),
+		}),
+		HandleConfirm: func() error {
+			accepted[result.commit.Hash()] = git_commands.CommitMessage{
+				Summary:     result.newMessage.Summary,
+				Description: result.newMessage.Description,
+			}
+			return next()
+		},
+		HandleClose: next,
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) rewordWithRegeneratedMessages(accepted map[string]git_commands.CommitMessage) error {
+	if len(accepted) == 0 {
+		return nil
+	}
+
+	// The commits may have changed while we were generating the messages, so
+	// we look up the accepted ones again. We only rebase as far down as the
+	// oldest of them.
+	commits := self.c.Model().Commits
+	indices := []int{}
+	for idx, commit := range commits {
+		if _, ok := accepted[commit.Hash()]; ok {
+			indices = append(indices, idx)
+		}
+	}
+	if len(indices) != len(accepted) {
+		return errors.New(self.c.Tr.RegeneratedCommitsChanged)
+	}
+
+	start, end := lo.Min(indices), lo.Max(indices)
+	if reason := self.canAmendRange(commits, start, end); reason != nil {
+		return errors.New(reason.Text)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.RewordingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.RegenerateCommitMessages)
+		err := self.c.Git().Rebase.RewordCommits(commits, start, end, accepted)
+		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+	})
+}
+
+func (self *LocalCommitsController) canRegenerateMessages(selectedCommits []*models.Commit, start, end int) *types.DisabledReason {
+	if lo.SomeBy(selectedCommits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		return &types.DisabledReason{Text: self.c.Tr.RewordNotSupported}
+	}
+
+	if self.c.Git().Config.NeedsGpgSubprocessForCommit() {
+		return &types.DisabledReason{Text: self.c.Tr.DisabledForGPG}
+	}
+
+	return self.canAmendRange(self.c.Model().Commits, start, end)
+}
+
 func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
 	if self.isRebasing() {
 		groupedTodos := lo.GroupBy(selectedCommits, func(c *models.Commit) bool {
@@ -853,6 +1057,13 @@ func (self *LocalCommitsController) amendAttribute(commits []*models.Commit, sta
 				Key:     opts.GetKey(opts.Config.AmendAttribute.AddCoAuthor),
 				Tooltip: self.c.Tr.AddCoAuthorTooltip,
 			},
+			{
+				Label:     self.c.Tr.EditTrailers,
+				OnPress:   func() error { return self.editTrailers(commits, start, end) },
+				Key:       opts.GetKey(opts.Config.AmendAttribute.EditTrailers),
+				Tooltip:   self.c.Tr.EditTrailersTooltip,
+				OpensMenu: true,
+			},
 		},
 	})
 }
@@ -908,6 +1119,32 @@ func (self *LocalCommitsController) addCoAuthor(start, end int) error {
 	return nil
 }
 
+func (self *LocalCommitsController) editTrailers(commits []*models.Commit, start, end int) error {
+	trailers := []git_commands.Trailer{}
+	for _, commit := range commits {
+		message, err := self.c.Git().Commit.GetCommitMessage(commit.Hash())
+		if err != nil {
+			return err
+		}
+		commitTrailers, err := self.c.Git().Commit.GetTrailers(message)
+		if err != nil {
+			return err
+		}
+		trailers = append(trailers, commitTrailers...)
+	}
+
+	return self.c.Helpers().Trailers.OpenTrailersMenu(trailers, func(edit helpers.TrailersEdit) error {
+		return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.EditCommitTrailers)
+			if err := self.c.Git().Rebase.EditCommitTrailers(self.c.Model().Commits, start, end, edit); err != nil {
+				return err
+			}
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			return nil
+		})
+	})
+}
+
 func (self *LocalCommitsController) revert(commits []*models.Commit, start, end int) error {
 	var promptText string
 	if len(commits) == 1 {
@@ -1185,6 +1422,190 @@ func (self *LocalCommitsController) createTag(commit *models.Commit) error {
 	return self.c.Helpers().Tags.OpenCreateTagPrompt(commit.Hash(), func() {})
 }
 
+func (self *LocalCommitsController) fetchLfsObjects(commits []*models.Commit, _, _ int) error {
+	return self.c.Helpers().Lfs.FetchObjects(commits)
+}
+
+func (self *LocalCommitsController) openRangeDiffMenu() error {
+	return self.c.Helpers().RangeDiff.OpenMenu(self.context())
+}
+
+func (self *LocalCommitsController) openBranchStackMenu(commit *models.Commit) error {
+	return self.c.Helpers().BranchStack.OpenMenu(commit)
+}
+
+func (self *LocalCommitsController) exportPatches(commits []*models.Commit, _, _ int) error {
+	return self.c.Helpers().PatchSeries.OpenExportMenu(commits)
+}
+
+func (self *LocalCommitsController) canExportPatches(commits []*models.Commit, _, _ int) *types.DisabledReason {
+	if lo.SomeBy(commits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CannotExportTodoCommits}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) canEditNotes(commit *models.Commit) *types.DisabledReason {
+	if commit.IsTODO() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotEditNotesOfTodo}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) openNotesMenu(commit *models.Commit) error {
+	notesRefs := self.c.Git().Notes.NotesRefs()
+	if len(notesRefs) == 1 {
+		return self.openNotesMenuForRef(commit, notesRefs[0])
+	}
+
+	menuItems := lo.Map(notesRefs, func(notesRef string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:     notesRef,
+			OnPress:   func() error { return self.openNotesMenuForRef(commit, notesRef) },
+			OpensMenu: true,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SelectNotesRef,
+		Items: menuItems,
+	})
+}
+
+func (self *LocalCommitsController) openNotesMenuForRef(commit *models.Commit, notesRef string) error {
+	note := self.c.Git().Notes.Get(notesRef, commit.Hash())
+	placeholders := map[string]string{"notesRef": notesRef}
+
+	var removeDisabledReason *types.DisabledReason
+	if note == "" {
+		removeDisabledReason = &types.DisabledReason{
+			Text: utils.ResolvePlaceholderString(self.c.Tr.CommitHasNoNote, placeholders),
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.NotesMenuTitle, placeholders),
+		Items: []*types.MenuItem{
+			{
+				Label:   lo.Ternary(note == "", self.c.Tr.AddNote, self.c.Tr.EditNote),
+				OnPress: func() error { return self.editNote(commit, notesRef, note) },
+				Key:     'e',
+				Tooltip: self.c.Tr.AddNoteTooltip,
+			},
+			{
+				Label:          self.c.Tr.RemoveNote,
+				OnPress:        func() error { return self.removeNote(commit, notesRef) },
+				Key:            'd',
+				Tooltip:        self.c.Tr.RemoveNoteTooltip,
+				DisabledReason: removeDisabledReason,
+			},
+			{
+				Label:   self.c.Tr.PushNotes,
+				OnPress: func() error { return self.pushNotes(notesRef) },
+				Key:     'p',
+				Tooltip: self.c.Tr.PushNotesTooltip,
+			},
+			{
+				Label:   self.c.Tr.FetchNotes,
+				OnPress: func() error { return self.fetchNotes(notesRef) },
+				Key:     'f',
+				Tooltip: self.c.Tr.FetchNotesTooltip,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) editNote(commit *models.Commit, notesRef string, note string) error {
+	self.c.Helpers().Commits.OpenCommitMessagePanel(
+		&helpers.OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   note,
+			SummaryTitle:     self.c.Tr.NoteSummaryTitle,
+			DescriptionTitle: self.c.Tr.NoteDescriptionTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				newNote := lo.Ternary(description == "", summary, summary+"\n\n"+description)
+				return self.c.WithWaitingStatus(self.c.Tr.SavingNoteStatus, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.SetNote)
+					if err := self.c.Git().Notes.Set(notesRef, commit.Hash(), newNote); err != nil {
+						return err
+					}
+
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+					return nil
+				})
+			},
+			OnSwitchToEditor: func(filepath string) error {
+				self.c.LogAction(self.c.Tr.Actions.SetNote)
+				return self.c.RunSubprocessAndRefresh(
+					self.c.Git().Notes.SetWithEditorCmdObj(notesRef, commit.Hash(), filepath))
+			},
+		},
+	)
+
+	return nil
+}
+
+func (self *LocalCommitsController) removeNote(commit *models.Commit, notesRef string) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RemoveNoteTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RemoveNotePrompt, map[string]string{
+			"commitHash": commit.ShortHash(),
+			"notesRef":   notesRef,
+		}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RemoveNote)
+			if err := self.c.Git().Notes.Remove(notesRef, commit.Hash()); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) pushNotes(notesRef string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               utils.ResolvePlaceholderString(self.c.Tr.PushNotesTitle, map[string]string{"notesRef": notesRef}),
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PushNotes)
+				return self.c.Git().Notes.Push(task, remoteName, notesRef)
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) fetchNotes(notesRef string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               utils.ResolvePlaceholderString(self.c.Tr.FetchNotesTitle, map[string]string{"notesRef": notesRef}),
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.FetchNotes)
+				if err := self.c.Git().Notes.Fetch(task, remoteName, notesRef); err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS}})
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) openSearch() error {
 	// we usually lazyload these commits but now that we're searching we need to load them now
 	if self.context().GetLimitCommits() {
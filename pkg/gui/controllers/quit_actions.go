@@ -68,6 +68,13 @@ func (self *QuitActions) Escape() error {
 		}
 	}
 
+	// A commit message generation can be started from several places and
+	// runs in the background, so it can be cancelled from anywhere
+	if self.c.Helpers().CommitGenerator.IsGenerating() {
+		self.c.Helpers().CommitGenerator.CancelGeneration()
+		return nil
+	}
+
 	parentContext := currentContext.GetParentContext()
 	if parentContext != nil {
 		// TODO: think about whether this should be marked as a return rather than adding to the stack
@@ -108,6 +115,10 @@ func (self *QuitActions) EscapeEnabled() bool {
 		}
 	}
 
+	if self.c.Helpers().CommitGenerator.IsGenerating() {
+		return true
+	}
+
 	parentContext := currentContext.GetParentContext()
 	if parentContext != nil {
 		return true
@@ -146,6 +157,10 @@ func (self *QuitActions) EscapeDescription() string {
 		}
 	}
 
+	if self.c.Helpers().CommitGenerator.IsGenerating() {
+		return self.c.Tr.CancelCommitGeneration
+	}
+
 	parentContext := currentContext.GetParentContext()
 	if parentContext != nil {
 		return self.c.Tr.ExitSubview
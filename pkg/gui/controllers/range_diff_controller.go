@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffController struct {
+	baseController
+	*ListControllerTrait[*models.RangeDiffPair]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RangeDiffController{}
+
+func NewRangeDiffController(
+	c *ControllerCommon,
+) *RangeDiffController {
+	return &RangeDiffController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().RangeDiff,
+			c.Contexts().RangeDiff.GetSelected,
+			c.Contexts().RangeDiff.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *RangeDiffController) Context() types.Context {
+	return self.context()
+}
+
+func (self *RangeDiffController) context() *context.RangeDiffContext {
+	return self.c.Contexts().RangeDiff
+}
+
+func (self *RangeDiffController) GetOnRenderToMain() func() {
+	return func() {
+		pair := self.context().GetSelected()
+		var task types.UpdateTask
+		if pair == nil {
+			task = types.NewRenderStringTask(self.c.Tr.RangeDiffNoCommits)
+		} else if pair.Status == models.RangeDiffModified {
+			task = types.NewRenderStringTask(presentation.ColorInterdiff(pair.Interdiff))
+		} else {
+			// There is no interdiff for commits that are the same in both
+			// ranges or only exist in one of them, so show the commit itself
+			cmdObj := self.c.Git().Commit.ShowCmdObj(pair.Hash(), nil)
+			task = types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), self.pairDescription(pair)+"\n\n")
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.RangeDiffTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+func (self *RangeDiffController) pairDescription(pair *models.RangeDiffPair) string {
+	switch pair.Status {
+	case models.RangeDiffRemoved:
+		return style.FgRed.Sprint(self.c.Tr.RangeDiffRemovedPair)
+	case models.RangeDiffAdded:
+		return style.FgGreen.Sprint(self.c.Tr.RangeDiffAddedPair)
+	default:
+		return style.FgBlue.Sprint(self.c.Tr.RangeDiffIdenticalPair)
+	}
+}
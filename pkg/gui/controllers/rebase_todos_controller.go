@@ -0,0 +1,278 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/stefanhaller/git-todo-parser/todo"
+)
+
+// Inserts, edits, and removes the todos of an interactive rebase that don't
+// have a commit: exec, label, reset, and merge. The latter three are what
+// --rebase-merges uses to recreate the structure of merged branches.
+type RebaseTodosController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RebaseTodosController{}
+
+func NewRebaseTodosController(
+	c *ControllerCommon,
+) *RebaseTodosController {
+	return &RebaseTodosController{
+		baseController: baseController{},
+		c:              c,
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().LocalCommits,
+			c.Contexts().LocalCommits.GetSelected,
+			c.Contexts().LocalCommits.GetSelectedItems,
+		),
+	}
+}
+
+func (self *RebaseTodosController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewRebaseTodoOptions),
+			Handler:           self.withItem(self.openMenu),
+			GetDisabledReason: self.require(self.singleItemSelected(), self.isRebasing),
+			Description:       self.c.Tr.ViewRebaseTodoOptions,
+			Tooltip:           self.c.Tr.ViewRebaseTodoOptionsTooltip,
+			OpensMenu:         true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *RebaseTodosController) isRebasing() *types.DisabledReason {
+	if !self.c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing {
+		return &types.DisabledReason{Text: self.c.Tr.RebaseTodoOptionsRequireRebase}
+	}
+
+	return nil
+}
+
+func (self *RebaseTodosController) openMenu(commit *models.Commit) error {
+	index := self.context().GetSelectedLineIdx()
+
+	// New todos can be inserted after any todo, and after the first commit
+	// below the todos, which is the one that HEAD points to (or the one that
+	// is being applied if there's a conflict)
+	var cannotInsert *types.DisabledReason
+	if index > self.todoCount() {
+		cannotInsert = &types.DisabledReason{Text: self.c.Tr.CannotInsertTodoHere}
+	}
+
+	var cannotEdit *types.DisabledReason
+	if commit.Status != models.StatusRebasing || !isEditableTodo(commit.Action) {
+		cannotEdit = &types.DisabledReason{Text: self.c.Tr.CannotEditThisTodo}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RebaseTodoOptionsTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.InsertExecTodo,
+				Tooltip: self.c.Tr.InsertExecTodoTooltip,
+				OnPress: func() error {
+					return self.promptForExecCommand("", func(command string) error {
+						return self.insertTodo(index, todo.Todo{Command: todo.Exec, ExecCommand: command})
+					})
+				},
+				DisabledReason: cannotInsert,
+				Key:            'x',
+			},
+			{
+				Label:   self.c.Tr.InsertLabelTodo,
+				Tooltip: self.c.Tr.InsertLabelTodoTooltip,
+				OnPress: func() error {
+					return self.promptForLabel(self.c.Tr.LabelTodoPrompt, "", false, func(label string) error {
+						return self.insertTodo(index, todo.Todo{Command: todo.Label, Label: label})
+					})
+				},
+				DisabledReason: cannotInsert,
+				Key:            'l',
+			},
+			{
+				Label:   self.c.Tr.InsertResetTodo,
+				Tooltip: self.c.Tr.InsertResetTodoTooltip,
+				OnPress: func() error {
+					return self.promptForLabel(self.c.Tr.ResetTodoPrompt, "", true, func(label string) error {
+						return self.insertTodo(index, todo.Todo{Command: todo.Reset, Label: label})
+					})
+				},
+				DisabledReason: cannotInsert,
+				Key:            'r',
+			},
+			{
+				Label:   self.c.Tr.InsertMergeTodo,
+				Tooltip: self.c.Tr.InsertMergeTodoTooltip,
+				OnPress: func() error {
+					return self.promptForLabel(self.c.Tr.MergeTodoPrompt, "", true, func(label string) error {
+						return self.insertTodo(index, todo.Todo{Command: todo.Merge, Label: label})
+					})
+				},
+				DisabledReason: cannotInsert,
+				Key:            'm',
+			},
+			{
+				Label:   self.c.Tr.EditRebaseTodo,
+				Tooltip: self.c.Tr.EditRebaseTodoTooltip,
+				OnPress: func() error {
+					return self.editTodo(index)
+				},
+				DisabledReason: cannotEdit,
+				Key:            'e',
+			},
+			{
+				Label: self.c.Tr.RemoveRebaseTodo,
+				OnPress: func() error {
+					return self.removeTodo(index)
+				},
+				DisabledReason: cannotEdit,
+				Key:            'd',
+			},
+		},
+	})
+}
+
+func isEditableTodo(action todo.TodoCommand) bool {
+	return action == todo.Exec || action == todo.Label || action == todo.Reset || action == todo.Merge
+}
+
+func (self *RebaseTodosController) todoCount() int {
+	return lo.CountBy(self.c.Model().Commits, func(commit *models.Commit) bool {
+		return commit.Status == models.StatusRebasing
+	})
+}
+
+func (self *RebaseTodosController) promptForExecCommand(initialContent string, f func(command string) error) error {
+	shellCommandAction := &ShellCommandAction{c: self.c}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ExecTodoPrompt,
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: shellCommandAction.GetShellCommandsHistorySuggestionsFunc(),
+		AllowEditSuggestion: true,
+		PreserveWhitespace:  true,
+		HandleConfirm: func(command string) error {
+			if strings.TrimSpace(command) == "" {
+				return nil
+			}
+
+			shellCommandAction.addToHistory(command)
+
+			return f(command)
+		},
+		HandleDeleteSuggestion: shellCommandAction.deleteFromHistory,
+	})
+
+	return nil
+}
+
+// Reset and merge todos can refer to any label of the rebase, so we suggest
+// those
+func (self *RebaseTodosController) promptForLabel(title string, initialContent string, suggestLabels bool, f func(label string) error) error {
+	var findSuggestionsFunc func(string) []*types.Suggestion
+	if suggestLabels {
+		labels, err := self.c.Git().Rebase.GetTodoLabels()
+		if err != nil {
+			return err
+		}
+		findSuggestionsFunc = helpers.FilterFunc(labels, self.c.UserConfig().Gui.UseFuzzySearch())
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               title,
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: findSuggestionsFunc,
+		HandleConfirm: func(label string) error {
+			if label == "" || strings.ContainsAny(label, " \t") {
+				return errors.New(self.c.Tr.InvalidTodoLabel)
+			}
+
+			return f(label)
+		},
+	})
+
+	return nil
+}
+
+func (self *RebaseTodosController) insertTodo(index int, newTodo todo.Todo) error {
+	self.c.LogAction(self.c.Tr.Actions.InsertRebaseTodo)
+	if err := self.c.Git().Rebase.InsertTodoAfter(self.c.Model().Commits, index, newTodo); err != nil {
+		return err
+	}
+
+	// The new todo is shown above the selected commit, at the selected index,
+	// so it becomes selected
+	self.refresh()
+	return nil
+}
+
+func (self *RebaseTodosController) editTodo(index int) error {
+	commits := self.c.Model().Commits
+	oldTodo, err := self.c.Git().Rebase.GetTodo(commits, index)
+	if err != nil {
+		return err
+	}
+
+	replace := func(newTodo todo.Todo) error {
+		self.c.LogAction(self.c.Tr.Actions.EditRebaseTodo)
+		if err := self.c.Git().Rebase.ReplaceTodo(commits, index, newTodo); err != nil {
+			return err
+		}
+
+		self.refresh()
+		return nil
+	}
+
+	if oldTodo.Command == todo.Exec {
+		return self.promptForExecCommand(oldTodo.ExecCommand, func(command string) error {
+			newTodo := oldTodo
+			newTodo.ExecCommand = command
+			return replace(newTodo)
+		})
+	}
+
+	title := map[todo.TodoCommand]string{
+		todo.Label: self.c.Tr.LabelTodoPrompt,
+		todo.Reset: self.c.Tr.ResetTodoPrompt,
+		todo.Merge: self.c.Tr.MergeTodoPrompt,
+	}[oldTodo.Command]
+
+	return self.promptForLabel(title, oldTodo.Label, oldTodo.Command != todo.Label, func(label string) error {
+		newTodo := oldTodo
+		newTodo.Label = label
+		return replace(newTodo)
+	})
+}
+
+func (self *RebaseTodosController) removeTodo(index int) error {
+	self.c.LogAction(self.c.Tr.Actions.RemoveRebaseTodo)
+	if err := self.c.Git().Rebase.RemoveTodo(self.c.Model().Commits, index); err != nil {
+		return err
+	}
+
+	self.refresh()
+	return nil
+}
+
+func (self *RebaseTodosController) refresh() {
+	self.c.Refresh(types.RefreshOptions{
+		Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+	})
+}
+
+func (self *RebaseTodosController) context() *context.LocalCommitsContext {
+	return self.c.Contexts().LocalCommits
+}
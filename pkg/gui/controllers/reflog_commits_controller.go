@@ -29,6 +29,18 @@ func NewReflogCommitsController(
 	}
 }
 
+func (self *ReflogCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewRangeDiffOptions),
+			Handler:           self.withItem(self.rangeDiffAgainstHead),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.RangeDiffAgainstHead,
+			Tooltip:           self.c.Tr.RangeDiffAgainstHeadTooltip,
+		},
+	}
+}
+
 func (self *ReflogCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -60,3 +72,7 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() {
 		})
 	}
 }
+
+func (self *ReflogCommitsController) rangeDiffAgainstHead(commit *models.Commit) error {
+	return self.c.Helpers().RangeDiff.CompareWithHead(commit, self.context())
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RerereResolutionsController struct {
+	baseController
+	*ListControllerTrait[*models.RerereResolution]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RerereResolutionsController{}
+
+func NewRerereResolutionsController(
+	c *ControllerCommon,
+) *RerereResolutionsController {
+	return &RerereResolutionsController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait(
+			c,
+			c.Contexts().RerereResolutions,
+			c.Contexts().RerereResolutions.GetSelected,
+			c.Contexts().RerereResolutions.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *RerereResolutionsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Remove),
+			Handler:           self.withItems(self.delete),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
+			Description:       self.c.Tr.RerereDeleteResolution,
+			Tooltip:           self.c.Tr.RerereDeleteResolutionTooltip,
+			DisplayOnScreen:   true,
+		},
+	}
+}
+
+func (self *RerereResolutionsController) Context() types.Context {
+	return self.context()
+}
+
+func (self *RerereResolutionsController) context() *context.RerereResolutionsContext {
+	return self.c.Contexts().RerereResolutions
+}
+
+func (self *RerereResolutionsController) GetOnRenderToMain() func() {
+	return func() {
+		resolution := self.context().GetSelected()
+		var task types.UpdateTask
+		if resolution == nil {
+			task = types.NewRenderStringTask(self.c.Tr.RerereNoResolutions)
+		} else if resolution.Resolved {
+			task = types.NewRunPtyTask(self.c.Git().Rerere.DiffCmdObj(resolution).GetCmd())
+		} else {
+			preimage, err := self.c.Git().Rerere.GetPreimage(resolution)
+			if err != nil {
+				preimage = err.Error()
+			}
+			task = types.NewRenderStringTask(style.FgRed.Sprint(self.c.Tr.RerereNotResolvedYet) + "\n\n" + preimage)
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.RerereResolutionTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+func (self *RerereResolutionsController) delete(resolutions []*models.RerereResolution) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RerereDeleteResolution,
+		Prompt: self.c.Tr.RerereDeleteResolutionPrompt,
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RerereDeleteResolution)
+			for _, resolution := range resolutions {
+				if err := self.c.Git().Rerere.DeleteResolution(resolution); err != nil {
+					return err
+				}
+			}
+
+			self.context().CollapseRangeSelectionToTop()
+			if err := self.c.Helpers().Rerere.ReloadResolutions(); err != nil {
+				return err
+			}
+			self.c.PostRefreshUpdate(self.context())
+			return nil
+		},
+	})
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -53,6 +54,30 @@ func (self *StagedFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Description:       self.c.Tr.Stage,
 			Tooltip:           self.c.Tr.StageTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.RunPreCommitHooks),
+			Handler:           self.c.Helpers().PreCommitHooks.RunPreCommitHooks,
+			GetDisabledReason: self.c.Helpers().PreCommitHooks.GetDisabledReason,
+			Description:       self.c.Tr.RunPreCommitHooks,
+			Tooltip:           self.c.Tr.RunPreCommitHooksTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:     opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler: self.withItem(self.c.Helpers().Blame.BlameWorkingTreeFile),
+			GetDisabledReason: self.require(
+				self.singleItemSelected(self.c.Helpers().Blame.GetDisabledReasonForWorkingTreeFile)),
+			Description: self.c.Tr.OpenBlame,
+			Tooltip:     self.c.Tr.OpenBlameTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ViewLfsOptions),
+			Handler:           self.withItem(self.c.Helpers().Lfs.OpenLfsMenu),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewLfsOptions,
+			Tooltip:           self.c.Tr.ViewLfsOptionsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.ToggleTreeView),
 			Handler:     self.toggleTreeView,
@@ -147,6 +172,21 @@ func (self *StagedFilesController) GetOnRenderToMain() func() {
 				}
 			}
 
+			if node.File != nil && node.File.IsLfs {
+				secondaryVersions := helpers.WorkingTreeLfsPointerDiffVersions(false, node.File.PreviousPath)
+				self.c.Helpers().Lfs.RenderPointerDiffs(
+					refreshOpts,
+					node.GetPath(),
+					helpers.WorkingTreeLfsPointerDiffVersions(true, node.File.PreviousPath),
+					&secondaryVersions,
+					func() bool {
+						selected := self.context().GetSelected()
+						return selected != nil && selected.GetPath() == node.GetPath()
+					},
+				)
+				return
+			}
+
 			self.c.RenderToMainViews(refreshOpts)
 		})
 	}
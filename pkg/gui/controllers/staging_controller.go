@@ -109,6 +109,13 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Description: self.c.Tr.FindBaseCommitForFixup,
 			Tooltip:     self.c.Tr.FindBaseCommitForFixupTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.AbsorbStagedChanges),
+			Handler:     self.c.Helpers().FixupHelper.HandleAbsorbStagedChangesPress,
+			Description: self.c.Tr.AbsorbStagedChanges,
+			Tooltip:     self.c.Tr.AbsorbStagedChangesTooltip,
+			OpensMenu:   true,
+		},
 	}
 }
 
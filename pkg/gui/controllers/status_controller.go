@@ -63,6 +63,19 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     func() error { self.switchToOrRotateAllBranchesLogs(); return nil },
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.RepoHealth),
+			Handler:     func() error { self.c.Helpers().RepoHealth.Render(); return nil },
+			Description: self.c.Tr.ShowRepoHealth,
+			Tooltip:     self.c.Tr.ShowRepoHealthTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.RepoMaintenance),
+			Handler:     self.c.Helpers().RepoHealth.OpenMaintenanceMenu,
+			Description: self.c.Tr.RepoMaintenance,
+			Tooltip:     self.c.Tr.RepoMaintenanceTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -85,6 +98,8 @@ func (self *StatusController) GetOnRenderToMain() func() {
 			self.showDashboard()
 		case "allBranchesLog":
 			self.showAllBranchLogs()
+		case "health":
+			self.c.Helpers().RepoHealth.Render()
 		default:
 			self.showDashboard()
 		}
@@ -89,7 +89,7 @@ func (self *SyncController) branchCheckedOut(f func(*models.Branch) error) func(
 }
 
 func (self *SyncController) push(currentBranch *models.Branch) error {
-	if customPushCmd, ok := self.resolveCustomPushCommand(); ok {
+	if customPushCmd, ok := self.ResolveCustomPushCommand(); ok {
 		return self.pushWithCustomCommand(currentBranch, customPushCmd)
 	}
 
@@ -258,7 +258,10 @@ func (self *SyncController) pushWithCustomCommand(currentBranch *models.Branch,
 	})
 }
 
-func (self *SyncController) resolveCustomPushCommand() (string, bool) {
+// ResolveCustomPushCommand returns the command from the GIT_PUSH_CMD variable
+// (read from the repo's .env file or the environment) that should be run
+// instead of git push, if there is one.
+func (self *SyncController) ResolveCustomPushCommand() (string, bool) {
 	repoPath := self.c.Git().RepoPaths.RepoPath()
 	if v, ok := readDotEnvValue(repoPath, "GIT_PUSH_CMD"); ok {
 		return v, true
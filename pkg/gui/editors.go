@@ -26,12 +26,16 @@ func (gui *Gui) commitMessageEditor(v *gocui.View, key gocui.Key, ch rune, mod g
 func (gui *Gui) commitDescriptionEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
 	matched := gui.handleEditorKeypress(v, key, ch, mod, true)
 	v.RenderTextArea()
+	// the subtitle of the summary view shows lint violations, some of which
+	// depend on the description
+	gui.c.Contexts().CommitMessage.RenderSubtitle()
 	return matched
 }
 
 func (gui *Gui) commitInputEditor(v *gocui.View, key gocui.Key, ch rune, mod gocui.Modifier) bool {
 	matched := gui.handleEditorKeypress(v, key, ch, mod, false)
 	v.RenderTextArea()
+	gui.helpers.CommitLint.RenderCommitInputViolations()
 	return matched
 }
 
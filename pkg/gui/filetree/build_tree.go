@@ -8,6 +8,16 @@ import (
 )
 
 func BuildTreeFromFiles(files []*models.File, showRootItem bool) *Node[models.File] {
+	root := BuildUncompressedTreeFromFiles(files, showRootItem)
+	root.Compress()
+
+	return root
+}
+
+// BuildUncompressedTreeFromFiles is like BuildTreeFromFiles, except that
+// directories with a single child directory get a node of their own rather
+// than being merged with their child, e.g. 'pkg/gui' is two nodes
+func BuildUncompressedTreeFromFiles(files []*models.File, showRootItem bool) *Node[models.File] {
 	root := &Node[models.File]{}
 
 	childrenMapsByNode := make(map[*Node[models.File]]map[string]*Node[models.File])
@@ -58,7 +68,6 @@ func BuildTreeFromFiles(files []*models.File, showRootItem bool) *Node[models.Fi
 	}
 
 	root.Sort()
-	root.Compress()
 
 	return root
 }
@@ -3,6 +3,12 @@ package filtering
 type Filtering struct {
 	path               string // the filename that gets passed to git log
 	author             string // the author that gets passed to git log
+	lineRange          string // the line range (e.g. "10,20" or ":funcname") that gets passed to git log -L
+	lineRangePath      string // the file that the line range refers to
+	lineRangeRevision  string // the revision whose version of the file the line range refers to, and that git log starts from; empty for HEAD
+	pickaxe            string // the string (or regex) whose occurrences commits must change, passed to git log -S (or -G)
+	pickaxeIsRegex     bool   // whether to pass the pickaxe to git log -G rather than -S
+	messagePattern     string // the pattern that gets passed to git log --grep
 	selectedCommitHash string // the commit that was selected before we entered filtering mode
 }
 
@@ -11,12 +17,18 @@ func New(path string, author string) Filtering {
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != "" || m.author != ""
+	return m.path != "" || m.author != "" || m.lineRange != "" || m.pickaxe != "" || m.messagePattern != ""
 }
 
 func (m *Filtering) Reset() {
 	m.path = ""
 	m.author = ""
+	m.lineRange = ""
+	m.lineRangePath = ""
+	m.lineRangeRevision = ""
+	m.pickaxe = ""
+	m.pickaxeIsRegex = false
+	m.messagePattern = ""
 }
 
 func (m *Filtering) SetPath(path string) {
@@ -35,6 +47,55 @@ func (m *Filtering) GetAuthor() string {
 	return m.author
 }
 
+func (m *Filtering) SetLineRange(lineRange string, path string, revision string) {
+	m.lineRange = lineRange
+	m.lineRangePath = path
+	m.lineRangeRevision = revision
+}
+
+func (m *Filtering) GetLineRange() string {
+	return m.lineRange
+}
+
+func (m *Filtering) GetLineRangePath() string {
+	return m.lineRangePath
+}
+
+func (m *Filtering) GetLineRangeRevision() string {
+	return m.lineRangeRevision
+}
+
+// Returns the argument for git log -L, e.g. "10,20:file" or ":funcname:file",
+// or an empty string if we're not filtering by a line range
+func (m *Filtering) GetLineRangeArg() string {
+	if m.lineRange == "" {
+		return ""
+	}
+
+	return m.lineRange + ":" + m.lineRangePath
+}
+
+func (m *Filtering) SetPickaxe(pickaxe string, isRegex bool) {
+	m.pickaxe = pickaxe
+	m.pickaxeIsRegex = isRegex
+}
+
+func (m *Filtering) GetPickaxe() string {
+	return m.pickaxe
+}
+
+func (m *Filtering) PickaxeIsRegex() bool {
+	return m.pickaxeIsRegex
+}
+
+func (m *Filtering) SetMessagePattern(pattern string) {
+	m.messagePattern = pattern
+}
+
+func (m *Filtering) GetMessagePattern() string {
+	return m.messagePattern
+}
+
 func (m *Filtering) SetSelectedCommitHash(hash string) {
 	m.selectedCommitHash = hash
 }
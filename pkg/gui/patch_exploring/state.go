@@ -369,6 +369,19 @@ func (s *State) LineIndicesOfAddedOrDeletedLinesInSelectedPatchRange() []int {
 	return indices
 }
 
+// Returns the first and last line numbers of the selected lines in the old
+// file, or in the new file if newFile is true
+func (s *State) SelectedLineRange(newFile bool) (int, int) {
+	start, end := s.SelectedPatchRange()
+	return s.patch.LineRangeOfLines(start, end, newFile)
+}
+
+// Returns the context of the hunk that the selected line is in (usually the
+// enclosing function's signature), or an empty string if there is none
+func (s *State) SelectedHunkContext() string {
+	return s.patch.HunkHeaderContext(s.patch.HunkContainingLine(s.GetSelectedPatchLineIdx()))
+}
+
 func (s *State) CurrentLineNumber() int {
 	return s.patch.LineNumberOfLine(s.patchLineIndices[s.selectedLineIdx])
 }
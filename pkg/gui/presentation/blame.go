@@ -0,0 +1,33 @@
+package presentation
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetBlameLineListDisplayStrings(blameLines []*models.BlameLine, authorLength int) [][]string {
+	return lo.Map(blameLines, func(blameLine *models.BlameLine, _ int) []string {
+		return getBlameLineDisplayStrings(blameLine, authorLength)
+	})
+}
+
+func getBlameLineDisplayStrings(blameLine *models.BlameLine, authorLength int) []string {
+	hashColor := style.FgYellow
+	if blameLine.IsUncommitted() {
+		hashColor = style.FgRed
+	}
+
+	return []string{
+		hashColor.Sprint(blameLine.ShortHash()),
+		authors.AuthorWithLength(blameLine.Author, authorLength),
+		style.FgCyan.Sprint(utils.UnixToTimeAgo(blameLine.AuthorTimestamp)),
+		style.FgBlue.Sprint(strconv.Itoa(blameLine.LineNumber)),
+		theme.DefaultTextColor.Sprint(blameLine.Content),
+	}
+}
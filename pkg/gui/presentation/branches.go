@@ -34,10 +34,11 @@ func GetBranchListDisplayStrings(
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	stack *models.BranchStack,
 ) [][]string {
 	return lo.Map(branches, func(branch *models.Branch, _ int) []string {
 		diffed := branch.Name == diffName
-		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, time.Now())
+		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, stack, time.Now())
 	})
 }
 
@@ -51,11 +52,13 @@ func getBranchDisplayStrings(
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
+	stack *models.BranchStack,
 	now time.Time,
 ) []string {
 	checkedOutByWorkTree := git_commands.CheckedOutByOtherWorktree(b, worktrees)
 	showCommitHash := fullDescription || userConfig.Gui.ShowBranchCommitHash
 	branchStatus := BranchStatus(b, itemOperation, tr, now, userConfig)
+	stackStatus := BranchStackStatus(b.Name, stack, tr)
 	divergence := divergenceStr(b, itemOperation, tr, userConfig)
 	worktreeIcon := lo.Ternary(icons.IsIconEnabled(), icons.LINKED_WORKTREE_ICON, fmt.Sprintf("(%s)", tr.LcWorktree))
 
@@ -80,6 +83,10 @@ func getBranchDisplayStrings(
 		availableWidth -= utils.StringWidth(utils.Decolorise(branchStatus)) + 1
 	}
 
+	if len(stackStatus) > 0 {
+		availableWidth -= utils.StringWidth(utils.Decolorise(stackStatus)) + 1
+	}
+
 	displayName := b.Name
 	if b.DisplayName != "" {
 		displayName = b.DisplayName
@@ -103,6 +110,9 @@ func getBranchDisplayStrings(
 	if len(branchStatus) > 0 {
 		coloredName = fmt.Sprintf("%s %s", coloredName, branchStatus)
 	}
+	if len(stackStatus) > 0 {
+		coloredName = fmt.Sprintf("%s %s", coloredName, stackStatus)
+	}
 
 	recencyColor := style.FgCyan
 	if b.Recency == "  *" {
@@ -169,6 +179,22 @@ func (m *colorMatcher) match(name string) (*style.TextStyle, bool) {
 	return nil, false
 }
 
+// BranchStackStatus returns the position of the branch in the stack, and how
+// far it is behind its base if that has moved on, or an empty string if the
+// branch isn't part of the stack
+func BranchStackStatus(branchName string, stack *models.BranchStack, tr *i18n.TranslationSet) string {
+	stackBranch, index, found := stack.Find(branchName)
+	if !found {
+		return ""
+	}
+
+	result := style.FgMagenta.Sprintf(tr.BranchStackPosition, index+1, len(stack.Branches))
+	if stackBranch.Behind > 0 {
+		result += " " + style.FgYellow.Sprintf(tr.BranchStackBehindBase, stackBranch.Behind)
+	}
+	return result
+}
+
 func BranchStatus(
 	branch *models.Branch,
 	itemOperation types.ItemOperation,
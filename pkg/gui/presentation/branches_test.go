@@ -30,6 +30,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		viewWidth            int
 		useIcons             bool
 		checkedOutByWorktree bool
+		stack                *models.BranchStack
 		showDivergenceCfg    string
 		expected             []string
 	}{
@@ -184,6 +185,34 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			expected:             []string{"1m", "12345678", "branch_name ✓", "origin branch_name", "commit title"},
 		},
 
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			stack: &models.BranchStack{Branches: []*models.StackBranch{
+				{Name: "base_branch", Ahead: 2},
+				{Name: "branch_name", Ahead: 1, Behind: 3},
+			}},
+			showDivergenceCfg: "none",
+			expected:          []string{"1m", "branch_name stack 2/2 3 behind base"},
+		},
+		{
+			branch:               &models.Branch{Name: "other_branch", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			stack: &models.BranchStack{Branches: []*models.StackBranch{
+				{Name: "base_branch", Ahead: 2},
+				{Name: "branch_name", Ahead: 1},
+			}},
+			showDivergenceCfg: "none",
+			expected:          []string{"1m", "other_branch"},
+		},
 		// Now tests for how we truncate the branch name when there's not enough room:
 		{
 			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
@@ -351,7 +380,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		}
 
 		t.Run(fmt.Sprintf("getBranchDisplayStrings_%d", i), func(t *testing.T) {
-			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, time.Time{})
+			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, s.stack, time.Time{})
 			assert.Equal(t, s.expected, strings)
 		})
 	}
@@ -56,6 +56,7 @@ func GetCommitListDisplayStrings(
 	endIdx int,
 	showGraph bool,
 	bisectInfo *git_commands.BisectInfo,
+	stack *models.BranchStack,
 ) [][]string {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -177,6 +178,18 @@ func GetCommitListDisplayStrings(
 					(hasRebaseUpdateRefsConfig || b.CommitHash != commits[0].Hash())
 		}))
 
+	// For the heads of stack branches whose base has moved on, how far they are
+	// behind it, so that it's visible which parts of the stack need restacking
+	stackBehindCounts := map[string]int{}
+	if stack != nil {
+		for _, stackBranch := range stack.Branches {
+			branch, found := lo.Find(branches, func(b *models.Branch) bool { return b.Name == stackBranch.Name })
+			if found && stackBranch.Behind > 0 && branchHeadsToVisualize.Includes(branch.CommitHash) {
+				stackBehindCounts[branch.CommitHash] = stackBranch.Behind
+			}
+		}
+	}
+
 	lines := make([][]string, 0, len(filteredCommits))
 	var bisectStatus BisectStatus
 	willBeRebased := markedBaseCommit == ""
@@ -191,6 +204,7 @@ func GetCommitListDisplayStrings(
 			common,
 			commit,
 			branchHeadsToVisualize,
+			stackBehindCounts,
 			hasRebaseUpdateRefsConfig,
 			cherryPickedCommitHashSet,
 			isMarkedBaseCommit,
@@ -342,6 +356,7 @@ func displayCommit(
 	common *common.Common,
 	commit *models.Commit,
 	branchHeadsToVisualize *set.Set[string],
+	stackBehindCounts map[string]int,
 	hasRebaseUpdateRefsConfig bool,
 	cherryPickedCommitHashSet *set.Set[string],
 	isMarkedBaseCommit bool,
@@ -408,8 +423,14 @@ func displayCommit(
 			commit.Status != models.StatusMerged &&
 			// Don't show branch head on a "pick" todo if the rebase.updateRefs config is on
 			!(commit.IsTODO() && hasRebaseUpdateRefsConfig) {
-			tagString = style.FgCyan.SetBold().Sprint(
-				lo.Ternary(icons.IsIconEnabled(), icons.BRANCH_ICON, "*") + " " + tagString)
+			branchHead := lo.Ternary(icons.IsIconEnabled(), icons.BRANCH_ICON, "*") + " "
+			if behind, ok := stackBehindCounts[commit.Hash()]; ok {
+				tagString = style.FgCyan.SetBold().Sprint(branchHead) +
+					style.FgYellow.Sprintf("↓%d ", behind) +
+					style.FgCyan.SetBold().Sprint(tagString)
+			} else {
+				tagString = style.FgCyan.SetBold().Sprint(branchHead + tagString)
+			}
 		}
 	}
 
@@ -433,6 +454,21 @@ func displayCommit(
 		mark = fmt.Sprintf("%s ", willBeRebased)
 	}
 
+	testString := ""
+	if commit.TestResult != nil {
+		testString = getTestResultGlyph(commit.TestResult.Status) + " "
+	}
+
+	signatureString := ""
+	if commit.Signature != nil && commit.Signature.IsSigned() {
+		signatureString = getSignatureGlyph(commit.Signature.Status) + " "
+	}
+
+	notesString := ""
+	if commit.HasNotes {
+		notesString = style.FgCyan.Sprint("✎") + " "
+	}
+
 	authorLength := common.UserConfig().Gui.CommitAuthorShortLength
 	if fullDescription {
 		authorLength = common.UserConfig().Gui.CommitAuthorLongLength
@@ -448,12 +484,42 @@ func displayCommit(
 		descriptionString,
 		actionString,
 		author,
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+tagString+testString+signatureString+notesString+theme.DefaultTextColor.Sprint(name),
 	)
 
 	return cols
 }
 
+func getTestResultGlyph(status models.CommitTestStatus) string {
+	switch status {
+	case models.CommitTestRunning:
+		return style.FgYellow.Sprint("…")
+	case models.CommitTestPassed:
+		return style.FgGreen.Sprint("✔")
+	case models.CommitTestFailed:
+		return style.FgRed.Sprint("✘")
+	}
+
+	return ""
+}
+
+func getSignatureGlyph(status models.SignatureStatus) string {
+	switch status {
+	case models.SignatureGood:
+		return style.FgGreen.Sprint("✓")
+	case models.SignatureUntrusted, models.SignatureExpired:
+		return style.FgYellow.Sprint("✓")
+	case models.SignatureUnverifiable:
+		return style.FgYellow.Sprint("?")
+	case models.SignatureRevoked, models.SignatureBad:
+		return style.FgRed.Sprint("✗")
+	case models.SignatureNone:
+		return ""
+	}
+
+	return ""
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
@@ -42,6 +42,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		endIdx                    int
 		showGraph                 bool
 		bisectInfo                *git_commands.BisectInfo
+		stack                     *models.BranchStack
 		expected                  string
 		focus                     bool
 	}{
@@ -90,6 +91,67 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "commit with notes",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", Tags: []string{"tag1"}, HasNotes: true},
+				{Name: "commit2", Hash: "hash2", HasNotes: true},
+				{Name: "commit3", Hash: "hash3"},
+			},
+			startIdx:                  0,
+			endIdx:                    3,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 tag1 ✎ commit1
+		hash2 ✎ commit2
+		hash3 commit3
+						`),
+		},
+		{
+			testName: "commit with signatures",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", HasNotes: true, Signature: &models.CommitSignature{Status: models.SignatureGood}},
+				{Name: "commit2", Hash: "hash2", Signature: &models.CommitSignature{Status: models.SignatureBad}},
+				{Name: "commit3", Hash: "hash3", Signature: &models.CommitSignature{Status: models.SignatureNone}},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 ✓ ✎ commit1
+		hash2 ✗ commit2
+		hash3 commit3
+		hash4 commit4
+						`),
+		},
+		{
+			testName: "commit with test results",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", TestResult: &models.CommitTestResult{Status: models.CommitTestRunning}},
+				{Name: "commit2", Hash: "hash2", TestResult: &models.CommitTestResult{Status: models.CommitTestFailed}, Signature: &models.CommitSignature{Status: models.SignatureGood}},
+				{Name: "commit3", Hash: "hash3", TestResult: &models.CommitTestResult{Status: models.CommitTestPassed}},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 … commit1
+		hash2 ✘ ✓ commit2
+		hash3 ✔ commit3
+		hash4 commit4
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commitOpts: []models.NewCommitOpts{
@@ -119,6 +181,39 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash4 commit4
 						`),
 		},
+		{
+			testName: "show how far stack branches are behind their base",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+				{Name: "commit2", Hash: "hash2"},
+				{Name: "commit3", Hash: "hash3"},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			branches: []*models.Branch{
+				{Name: "current-branch", CommitHash: "hash1", Head: true},
+				{Name: "middle-branch", CommitHash: "hash2", Head: false},
+				{Name: "bottom-branch", CommitHash: "hash3", Head: false},
+			},
+			stack: &models.BranchStack{Branches: []*models.StackBranch{
+				{Name: "bottom-branch", Base: "refs/heads/master", Ahead: 2},
+				{Name: "middle-branch", Base: "bottom-branch", Ahead: 1, Behind: 2},
+				{Name: "current-branch", Base: "middle-branch", Ahead: 1},
+			}},
+			currentBranchName:         "current-branch",
+			hasUpdateRefConfig:        true,
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 commit1
+		hash2 * ↓2 commit2
+		hash3 * commit3
+		hash4 commit4
+						`),
+		},
 		{
 			testName: "show local branch head for head commit if updateRefs is on",
 			commitOpts: []models.NewCommitOpts{
@@ -569,6 +664,7 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.endIdx,
 					s.showGraph,
 					s.bisectInfo,
+					s.stack,
 				)
 
 				renderedLines, _ := utils.RenderDisplayStrings(result, nil)
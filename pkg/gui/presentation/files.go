@@ -174,6 +174,18 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && file.IsLfs {
+		output += theme.DefaultTextColor.Sprint(" (LFS)")
+	}
+
+	if file != nil && file.IsRerereResolved {
+		output += theme.DefaultTextColor.Sprint(" (rerere)")
+	}
+
+	if file != nil && file.IsSkipWorktree {
+		output += theme.DefaultTextColor.Sprint(" (skip-worktree)")
+	}
+
 	if file != nil && showNumstat {
 		if lineChanges := formatLineChanges(file.LinesAdded, file.LinesDeleted); lineChanges != "" {
 			output += " " + lineChanges
@@ -315,6 +327,11 @@ func getCommitFileLine(
 	}
 
 	output += nameColor.Sprint(name)
+
+	if commitFile != nil && commitFile.IsLfs {
+		output += theme.DefaultTextColor.Sprint(" (LFS)")
+	}
+
 	return output
 }
 
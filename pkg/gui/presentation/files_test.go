@@ -42,6 +42,19 @@ func TestRenderFileTree(t *testing.T) {
 			showRootItem: true,
 			expected:     []string{" M test"},
 		},
+		{
+			name: "lfs file",
+			files: []*models.File{
+				{Path: "image.psd", ShortStatus: " M", HasStagedChanges: true, IsLfs: true},
+				{Path: "readme.md", ShortStatus: " M", HasStagedChanges: true},
+			},
+			showRootItem: true,
+			expected: []string{
+				"▼ /",
+				"   M image.psd (LFS)",
+				"   M readme.md",
+			},
+		},
 		{
 			name: "numstat",
 			files: []*models.File{
@@ -151,6 +164,14 @@ func TestRenderCommitFileTree(t *testing.T) {
 			showRootItem: true,
 			expected:     []string{"A test"},
 		},
+		{
+			name: "lfs file",
+			files: []*models.CommitFile{
+				{Path: "image.psd", ChangeStatus: "M", IsLfs: true},
+			},
+			showRootItem: true,
+			expected:     []string{"M image.psd (LFS)"},
+		},
 		{
 			name: "big example",
 			files: []*models.CommitFile{
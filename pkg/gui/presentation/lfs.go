@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+)
+
+// GetLfsPointerDiff describes how the LFS object of a file changed between two
+// versions, to show instead of the diff of its pointer files. Either pointer
+// is nil if the file doesn't exist in that version.
+func GetLfsPointerDiff(oldPointer *git_commands.LfsPointer, newPointer *git_commands.LfsPointer, tr *i18n.TranslationSet) string {
+	lines := []string{style.AttrBold.Sprint(tr.LfsObjectDiffTitle), ""}
+
+	if oldPointer != nil && newPointer != nil && oldPointer.Oid != "" && oldPointer.Oid == newPointer.Oid {
+		lines = append(lines, formatLfsPointer(newPointer, " ", tr)...)
+		lines = append(lines, "", tr.LfsObjectUnchanged)
+		return strings.Join(lines, "\n")
+	}
+
+	if oldPointer != nil {
+		lines = append(lines, style.FgRed.Sprint(strings.Join(formatLfsPointer(oldPointer, "-", tr), "\n")))
+	}
+	if newPointer != nil {
+		lines = append(lines, style.FgGreen.Sprint(strings.Join(formatLfsPointer(newPointer, "+", tr), "\n")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatLfsPointer(pointer *git_commands.LfsPointer, prefix string, tr *i18n.TranslationSet) []string {
+	lines := []string{}
+	if pointer.Oid != "" {
+		lines = append(lines, fmt.Sprintf("%s oid  %s", prefix, pointer.Oid))
+	}
+	lines = append(lines, fmt.Sprintf("%s size %s (%d bytes)", prefix, formatByteSize(pointer.Size), pointer.Size))
+	if pointer.NotAPointer {
+		lines = append(lines, fmt.Sprintf("%s %s", prefix, tr.LfsNotAPointer))
+	}
+	return lines
+}
+
+func formatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,78 @@
+package presentation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetRangeDiffPairListDisplayStrings(pairs []*models.RangeDiffPair) [][]string {
+	return lo.Map(pairs, func(pair *models.RangeDiffPair, _ int) []string {
+		return getRangeDiffPairDisplayStrings(pair)
+	})
+}
+
+func getRangeDiffPairDisplayStrings(pair *models.RangeDiffPair) []string {
+	return []string{
+		rangeDiffSide(pair.OldIndex, pair.OldHash),
+		rangeDiffStatusStyle(pair.Status).Sprint(RangeDiffStatusSymbol(pair.Status)),
+		rangeDiffSide(pair.NewIndex, pair.NewHash),
+		theme.DefaultTextColor.Sprint(pair.Subject),
+	}
+}
+
+func rangeDiffSide(index int, hash string) string {
+	if index == 0 {
+		return "-"
+	}
+
+	return strconv.Itoa(index) + ": " + style.FgYellow.Sprint(hash)
+}
+
+// The symbol that git range-diff uses for the status
+func RangeDiffStatusSymbol(status models.RangeDiffStatus) string {
+	switch status {
+	case models.RangeDiffModified:
+		return "!"
+	case models.RangeDiffRemoved:
+		return "<"
+	case models.RangeDiffAdded:
+		return ">"
+	default:
+		return "="
+	}
+}
+
+func rangeDiffStatusStyle(status models.RangeDiffStatus) style.TextStyle {
+	switch status {
+	case models.RangeDiffModified:
+		return style.FgYellow
+	case models.RangeDiffRemoved:
+		return style.FgRed
+	case models.RangeDiffAdded:
+		return style.FgGreen
+	default:
+		return style.FgBlue
+	}
+}
+
+// Colors an interdiff by its outer diff markers, i.e. by whether a line was
+// added to or removed from the patch, the same way git range-diff does
+func ColorInterdiff(interdiff string) string {
+	lines := strings.Split(interdiff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = style.FgCyan.Sprint(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = style.FgGreen.Sprint(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = style.FgRed.Sprint(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,83 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// git gc --auto packs the loose objects once there are more than this many
+// (the default of gc.auto)
+const gcAutoLooseObjects = 6700
+
+func GetRepoHealthDashboard(health *models.RepoHealth, maintenanceKey string, tr *i18n.TranslationSet) string {
+	rows := [][]string{}
+	addRow := func(label string, value string) {
+		rows = append(rows, []string{label + ":", value})
+	}
+
+	if objects := health.Objects; objects != nil {
+		looseObjects := fmt.Sprintf("%d, %s", objects.LooseObjects, formatByteSize(objects.LooseSize))
+		if objects.LooseObjects > gcAutoLooseObjects {
+			looseObjects = style.FgYellow.Sprintf("%s (%s)", looseObjects, tr.RepoHealthTooManyLooseObjects)
+		}
+		addRow(tr.RepoHealthLooseObjects, looseObjects)
+		addRow(tr.RepoHealthPackedObjects, utils.ResolvePlaceholderString(tr.RepoHealthPackedObjectsValue, map[string]string{
+			"count": fmt.Sprint(objects.PackedObjects),
+			"packs": fmt.Sprint(objects.Packs),
+			"size":  formatByteSize(objects.PackSize),
+		}))
+		if objects.PrunePackable > 0 {
+			addRow(tr.RepoHealthPrunableObjects, style.FgYellow.Sprint(objects.PrunePackable))
+		}
+		if objects.Garbage > 0 {
+			addRow(tr.RepoHealthGarbage, style.FgYellow.Sprintf("%d, %s", objects.Garbage, formatByteSize(objects.GarbageSize)))
+		}
+	}
+
+	addRow(tr.RepoHealthCommitGraph, lo.Ternary(health.HasCommitGraph,
+		style.FgGreen.Sprint(tr.RepoHealthPresent),
+		style.FgYellow.Sprint(tr.RepoHealthCommitGraphMissing)))
+	addRow(tr.RepoHealthMaintenance, lo.Ternary(health.MaintenanceRegistered,
+		style.FgGreen.Sprint(tr.RepoHealthRegistered),
+		tr.RepoHealthNotRegistered))
+
+	lines, _ := utils.RenderDisplayStrings(rows, nil)
+	lines = append(lines, "")
+
+	lines = append(lines, healthList(tr.RepoHealthStaleWorktrees, tr,
+		lo.Map(health.StaleWorktrees, func(worktree *models.Worktree, _ int) string {
+			return fmt.Sprintf("%s (%s)", worktree.Name, worktree.Path)
+		}))...)
+	lines = append(lines, healthList(tr.RepoHealthGoneUpstreams, tr,
+		lo.Map(health.GoneUpstreamBranches, func(branch *models.Branch, _ int) string {
+			return fmt.Sprintf("%s (%s)", branch.Name, branch.ShortUpstreamRefName())
+		}))...)
+	lines = append(lines, healthList(tr.RepoHealthLargeUntrackedFiles, tr,
+		lo.Map(health.LargeUntrackedFiles, func(file *models.LargeFile, _ int) string {
+			return fmt.Sprintf("%s (%s)", file.Path, formatByteSize(file.Size))
+		}))...)
+
+	lines = append(lines, utils.ResolvePlaceholderString(tr.RepoHealthMaintenanceHint, map[string]string{
+		"key": maintenanceKey,
+	}))
+
+	return style.AttrBold.Sprint(tr.RepoHealthTitle) + "\n\n" + strings.Join(lines, "\n")
+}
+
+func healthList(title string, tr *i18n.TranslationSet, items []string) []string {
+	if len(items) == 0 {
+		return []string{fmt.Sprintf("%s: %s", title, style.FgGreen.Sprint(tr.RepoHealthNone)), ""}
+	}
+
+	lines := []string{title + ":"}
+	for _, item := range items {
+		lines = append(lines, style.FgYellow.Sprint("  "+item))
+	}
+	return append(lines, "")
+}
@@ -0,0 +1,33 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+)
+
+// GetSparseCheckoutDirectoryColumns renders a directory node of the tree of
+// tracked files for picking the directories of a sparse checkout, marking
+// directories whose files aren't all checked out. The node's name is shown
+// relative to its parent's path, indented by the given depth.
+func GetSparseCheckoutDirectoryColumns(
+	node *filetree.Node[models.File],
+	parentPath string,
+	depth int,
+	tr *i18n.TranslationSet,
+) []string {
+	name := strings.TrimPrefix(node.GetPath(), parentPath+"/") + "/"
+
+	status := ""
+	isSkipWorktree := func(file *models.File) bool { return file.IsSkipWorktree }
+	if node.EveryFile(isSkipWorktree) {
+		status = style.FgRed.Sprint(tr.SparseCheckoutNotCheckedOut)
+	} else if node.SomeFile(isSkipWorktree) {
+		status = style.FgYellow.Sprint(tr.SparseCheckoutPartiallyCheckedOut)
+	}
+
+	return []string{strings.Repeat("  ", depth) + name, status}
+}
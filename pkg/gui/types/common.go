@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
@@ -287,15 +288,18 @@ func (self *MenuItem) ID() string {
 }
 
 type Model struct {
-	CommitFiles  []*models.CommitFile
-	Files        []*models.File
-	Submodules   []*models.SubmoduleConfig
-	Branches     []*models.Branch
-	Commits      []*models.Commit
-	StashEntries []*models.StashEntry
-	SubCommits   []*models.Commit
-	Remotes      []*models.Remote
-	Worktrees    []*models.Worktree
+	CommitFiles       []*models.CommitFile
+	Files             []*models.File
+	Submodules        []*models.SubmoduleConfig
+	Branches          []*models.Branch
+	Commits           []*models.Commit
+	StashEntries      []*models.StashEntry
+	SubCommits        []*models.Commit
+	Remotes           []*models.Remote
+	Worktrees         []*models.Worktree
+	BlameLines        []*models.BlameLine
+	RangeDiffPairs    []*models.RangeDiffPair
+	RerereResolutions []*models.RerereResolution
 
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
 	// When in filtering mode we only include the ones that match the given path
@@ -317,12 +321,41 @@ type Model struct {
 
 	MainBranches *git_commands.MainBranches
 
+	// The kind of pre-commit hooks the repo has, and whether the pre-commit
+	// executable is installed in case they are managed by the pre-commit
+	// framework. Detected whenever the files are refreshed.
+	PreCommitHooks              git_commands.PreCommitHooksKind
+	PreCommitFrameworkInstalled bool
+
+	// The stack of branches that the checked-out branch is part of, or nil if
+	// it isn't part of one. Loaded in the background after the branches.
+	BranchStack *models.BranchStack
+
 	// for displaying suggestions while typing in a file name
 	FilesTrie *patricia.Trie
 
 	Authors map[string]*models.Author
 
+	// The signatures that we verified so far, by commit hash, so that we don't
+	// need to verify them again whenever we reload the commits
+	CommitSignatures map[string]*models.CommitSignature
+	// Identifies the config and keys that CommitSignatures were verified
+	// with; see CommitCommands.SignatureVerificationKey
+	CommitSignaturesKey string
+
+	// The results of testing commits, loaded from the git dir when they are
+	// first needed; nil until then
+	CommitTestResults *models.CommitTestResults
+	// The tree hashes of the commits that we looked up so far, by commit hash,
+	// to find their test results
+	CommitTreeHashes map[string]string
+
 	HashPool *utils.StringPool
+
+	// Paths of the files whose conflicts rerere resolved in the current merge,
+	// rebase etc. Git doesn't record this, and once these files are staged we
+	// can't tell anymore, so we remember them until the operation is done.
+	RerereResolvedPaths *set.Set[string]
 }
 
 type Mutexes struct {
@@ -332,6 +365,8 @@ type Mutexes struct {
 	LocalCommitsMutex       deadlock.Mutex
 	SubCommitsMutex         deadlock.Mutex
 	AuthorsMutex            deadlock.Mutex
+	CommitSignaturesMutex   deadlock.Mutex
+	CommitTestsMutex        deadlock.Mutex
 	SubprocessMutex         deadlock.Mutex
 	PopupMutex              deadlock.Mutex
 	PtyMutex                deadlock.Mutex
@@ -27,6 +27,7 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	Blame                  *gocui.View
 
 	Options           *gocui.View
 	Confirmation      *gocui.View
@@ -36,6 +37,8 @@ type Views struct {
 	CommitDescription *gocui.View
 	CommitFiles       *gocui.View
 	SubCommits        *gocui.View
+	RangeDiff         *gocui.View
+	RerereResolutions *gocui.View
 	Information       *gocui.View
 	AppStatus         *gocui.View
 	Search            *gocui.View
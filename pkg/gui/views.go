@@ -44,6 +44,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Commits, name: "commits"},
 		{viewPtr: &gui.Views.Stash, name: "stash"},
 		{viewPtr: &gui.Views.SubCommits, name: "subCommits"},
+		{viewPtr: &gui.Views.RangeDiff, name: "rangeDiff"},
+		{viewPtr: &gui.Views.RerereResolutions, name: "rerereResolutions"},
 		{viewPtr: &gui.Views.CommitFiles, name: "commitFiles"},
 
 		{viewPtr: &gui.Views.Staging, name: "staging"},
@@ -51,6 +53,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -116,6 +119,7 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.PatchBuilding.Wrap = true
 	gui.Views.PatchBuildingSecondary.Wrap = true
 	gui.Views.MergeConflicts.Wrap = false
+	gui.Views.Blame.Wrap = false
 	gui.Views.Limit.Wrap = true
 
 	gui.Views.AppStatus.BgColor = gocui.ColorDefault
@@ -213,12 +217,13 @@ func (gui *Gui) configureViewProperties() {
 	gui.Views.CommitDescription.Title = gui.c.Tr.CommitDescriptionTitle
 	gui.Views.Extras.Title = gui.c.Tr.CommandLog
 	gui.Views.Snake.Title = gui.c.Tr.SnakeTitle
+	gui.Views.RerereResolutions.Title = gui.c.Tr.RerereResolutionsTitle
 
 	gui.c.SetViewContent(gui.Views.CommitButton, fmt.Sprintf("[ %s ]", gui.c.Tr.Actions.Commit))
 	gui.c.SetViewContent(gui.Views.CommitGenerateButton, "[ Generate ]")
 	gui.c.SetViewContent(gui.Views.CommitPushButton, fmt.Sprintf("[ %s ]", gui.c.Tr.Actions.Push))
 
-	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary, gui.Views.Staging, gui.Views.StagingSecondary, gui.Views.PatchBuilding, gui.Views.PatchBuildingSecondary, gui.Views.MergeConflicts} {
+	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary, gui.Views.Staging, gui.Views.StagingSecondary, gui.Views.PatchBuilding, gui.Views.PatchBuildingSecondary, gui.Views.MergeConflicts, gui.Views.Blame} {
 		view.Title = gui.c.Tr.DiffTitle
 		view.CanScrollPastBottom = gui.c.UserConfig().Gui.ScrollPastBottom
 		view.TabWidth = gui.c.UserConfig().Gui.TabWidth
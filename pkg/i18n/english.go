@@ -321,6 +321,7 @@ type TranslationSet struct {
 	ViewRebaseOptions                     string
 	ViewCherryPickOptions                 string
 	ViewRevertOptions                     string
+	ViewApplyPatchesOptions               string
 	NotMergingOrRebasing                  string
 	AlreadyRebasing                       string
 	NotMidRebase                          string
@@ -330,6 +331,7 @@ type TranslationSet struct {
 	RebaseOptionsTitle                    string
 	CherryPickOptionsTitle                string
 	RevertOptionsTitle                    string
+	ApplyPatchesOptionsTitle              string
 	CommitSummaryTitle                    string
 	CommitDescriptionTitle                string
 	CommitDescriptionSubTitle             string
@@ -417,6 +419,7 @@ type TranslationSet struct {
 	LowercaseMergingStatus                string
 	LowercaseCherryPickingStatus          string
 	LowercaseRevertingStatus              string
+	LowercaseApplyingPatchesStatus        string
 	AmendingStatus                        string
 	CherryPickingStatus                   string
 	UndoingStatus                         string
@@ -425,6 +428,7 @@ type TranslationSet struct {
 	CommittingStatus                      string
 	RewordingStatus                       string
 	RevertingStatus                       string
+	ApplyingPatchesStatus                 string
 	CreatingFixupCommitStatus             string
 	MovingCommitsToNewBranchStatus        string
 	CommitFiles                           string
@@ -644,8 +648,20 @@ type TranslationSet struct {
 	ExitFilterMode                        string
 	FilterPathOption                      string
 	FilterAuthorOption                    string
+	FilterByLinesOption                   string
+	FilterByFunctionOption                string
+	FilterFunctionOption                  string
+	FilterPickaxeOption                   string
+	FilterPickaxeRegexOption              string
+	FilterMessageOption                   string
+	FilterSearchTooltip                   string
+	FilterByLinesTooltip                  string
 	EnterFileName                         string
 	EnterAuthor                           string
+	EnterFunctionName                     string
+	EnterPickaxeString                    string
+	EnterPickaxeRegex                     string
+	EnterMessagePattern                   string
 	FilteringMenuTitle                    string
 	WillCancelExistingFilterTooltip       string
 	MustExitFilterModeTitle               string
@@ -923,6 +939,341 @@ type TranslationSet struct {
 	UseCurrentChanges                        string
 	UseIncomingChanges                       string
 	UseBothChanges                           string
+	CommitGeneratorMenuTitle                 string
+	GeneratingCommitMessageStatus            string
+	NoStagedChangesToGenerateFrom            string
+	CommitGeneratorEmptyMessage              string
+	CommitGeneratorTimedOut                  string
+	CommitGenerationInProgress               string
+	CommitGenerationCancelled                string
+	GenerateCommitMessage                    string
+	CommandDoesNotSupportGeneratingMessage   string
+	RegenerateCommitMessage                  string
+	RegenerateCommitMessageTooltip           string
+	GeneratingCommitMessagesStatus           string
+	RegeneratedCommitMessageTitle            string
+	RegeneratedCommitMessagePrompt           string
+	CommitLintNotConventional                string
+	CommitLintUnknownType                    string
+	CommitLintUnknownScope                   string
+	CommitLintSummaryTooLong                 string
+	CommitLintNotImperative                  string
+	CommitLintMissingTrailer                 string
+	CommitLintTrailerMismatch                string
+	CommitLintViolationsSubtitle             string
+	CommitLintViolationsTitle                string
+	CommitLintViolationsPrompt               string
+	EditTrailers                             string
+	EditTrailersTooltip                      string
+	TrailersMenuTitle                        string
+	AddTrailer                               string
+	OtherTrailerKey                          string
+	TrailerKeyPromptTitle                    string
+	TrailerValuePromptTitle                  string
+	EditTrailerValue                         string
+	RemoveTrailer                            string
+	InvalidTrailerKey                        string
+	TrailerValueMustNotBeEmpty               string
+	CommitAndPush                            string
+	CommitAndPushSummaryTitle                string
+	CommitPushStepCommitted                  string
+	CommitPushStepNothingCommitted           string
+	CommitPushStepCommitFailed               string
+	CommitPushStepPushed                     string
+	CommitPushStepPushedAndSetUpstream       string
+	CommitPushStepPushFailed                 string
+	CommitPushStepRanCustomPushCommand       string
+	CommitPushStepCustomPushCommandFailed    string
+	CommitPushStepPushRejected               string
+	CommitPushStepPulled                     string
+	CommitPushStepPullFailed                 string
+	CommitPushStepNotPulled                  string
+	PushRejectedTitle                        string
+	PullRebaseAndRetryPrompt                 string
+	GitCommitTemplate                        string
+	CommitTemplatesMenuTitle                 string
+	NoCommitTemplates                        string
+	CommitMessageHistoryTitle                string
+	NoCommitMessagesInHistory                string
+	InsertCommitTemplate                     string
+	InsertCommitTemplateTooltip              string
+	PickCommitMessageFromHistory             string
+	PickCommitMessageFromHistoryTooltip      string
+	ReplaceCommitMessageTitle                string
+	SureReplaceCommitMessage                 string
+	NoPreCommitHooks                         string
+	NoStagedFilesToRunHooksOn                string
+	RunningPreCommitHooksStatus              string
+	RunPreCommitHooks                        string
+	RunPreCommitHooksTooltip                 string
+	PreCommitHookResultsTitle                string
+	StageFilesModifiedByHooks                string
+	NoFilesModifiedByHooks                   string
+	SkipHooksForNextCommit                   string
+	SkipHooksForNextCommitTooltip            string
+	SkipHookPrefixNotConfigured              string
+	BlameDynamicTitle                        string
+	OpenBlame                                string
+	OpenBlameTooltip                         string
+	LoadingBlame                             string
+	BlameGoToCommit                          string
+	BlameGoToCommitTooltip                   string
+	BlameParentCommit                        string
+	BlameParentCommitTooltip                 string
+	BlameToggleIgnoreWhitespace              string
+	BlameToggleIgnoreWhitespaceTooltip       string
+	BlameToggleDetectMoves                   string
+	BlameToggleDetectMovesTooltip            string
+	BlameToggleDetectCopies                  string
+	BlameToggleDetectCopiesTooltip           string
+	ExitBlame                                string
+	ExitBlameTooltip                         string
+	BlameLineNotCommitted                    string
+	BlameLineHasNoParent                     string
+	NoHistoryToBlame                         string
+	CannotBlameDeletedFile                   string
+	CommitNotInCommitsPanel                  string
+	CannotBlameDirectory                     string
+	ViewNotesOptions                         string
+	ViewNotesOptionsTooltip                  string
+	NotesMenuTitle                           string
+	SelectNotesRef                           string
+	AddNote                                  string
+	EditNote                                 string
+	AddNoteTooltip                           string
+	RemoveNote                               string
+	RemoveNoteTooltip                        string
+	RemoveNoteTitle                          string
+	RemoveNotePrompt                         string
+	CommitHasNoNote                          string
+	PushNotes                                string
+	PushNotesTooltip                         string
+	PushNotesTitle                           string
+	FetchNotes                               string
+	FetchNotesTooltip                        string
+	FetchNotesTitle                          string
+	NoteSummaryTitle                         string
+	NoteDescriptionTitle                     string
+	CannotEditNotesOfTodo                    string
+	SavingNoteStatus                         string
+	LfsObjectDiffTitle                       string
+	LfsObjectUnchanged                       string
+	LfsNotAPointer                           string
+	ViewLfsOptions                           string
+	ViewLfsOptionsTooltip                    string
+	LfsLockFile                              string
+	LfsLockFileTooltip                       string
+	LfsUnlockFile                            string
+	LfsUnlockFileTooltip                     string
+	LfsListLocks                             string
+	LfsListLocksTooltip                      string
+	LfsLocksTitle                            string
+	LfsNoLocks                               string
+	LfsForceUnlockPrompt                     string
+	LockingFileStatus                        string
+	UnlockingFileStatus                      string
+	LoadingLfsLocks                          string
+	CannotLockDirectory                      string
+	FetchLfsObjects                          string
+	FetchLfsObjectsTooltip                   string
+	FetchLfsObjectsTitle                     string
+	FetchingLfsObjectsStatus                 string
+	ViewSparseCheckoutOptions                string
+	ViewSparseCheckoutOptionsTooltip         string
+	SparseCheckoutConeModeTitle              string
+	SparseCheckoutNonConeModeTitle           string
+	SparseCheckoutDirectories                string
+	SparseCheckoutPatterns                   string
+	SparseCheckoutOnlyTopLevelFiles          string
+	SparseCheckoutActions                    string
+	SparseCheckoutRemoveTooltip              string
+	SparseCheckoutRemoveTitle                string
+	SparseCheckoutRemovePrompt               string
+	SparseCheckoutPickDirectories            string
+	SparseCheckoutPickDirectoriesTooltip     string
+	SparseCheckoutPickDirectoryTitle         string
+	SparseCheckoutNotCheckedOut              string
+	SparseCheckoutPartiallyCheckedOut        string
+	SparseCheckoutIncludedByParent           string
+	SparseCheckoutSwitchToConeMode           string
+	SparseCheckoutSwitchToConeModeTooltip    string
+	SparseCheckoutSwitchToNonConeMode        string
+	SparseCheckoutSwitchToNonConeModeTooltip string
+	SparseCheckoutDisable                    string
+	SparseCheckoutDisableTooltip             string
+	SparseCheckoutEnableConeMode             string
+	SparseCheckoutEnableConeModeTooltip      string
+	SparseCheckoutEnableNonConeMode          string
+	SparseCheckoutEnableNonConeModeTooltip   string
+	UpdatingSparseCheckoutStatus             string
+	LoadingTrackedFilesStatus                string
+	RangeDiffDynamicTitle                    string
+	ViewRangeDiffOptions                     string
+	ViewRangeDiffOptionsTooltip              string
+	RangeDiffUpstreamVsLocal                 string
+	RangeDiffUpstreamVsLocalTooltip          string
+	RangeDiffReflogEntryVsHead               string
+	RangeDiffReflogEntryVsHeadTooltip        string
+	RangeDiffEnterRanges                     string
+	RangeDiffEnterRangesTooltip              string
+	RangeDiffOldRangePrompt                  string
+	RangeDiffNewRangePrompt                  string
+	RangeDiffPickReflogEntryTitle            string
+	RangeDiffNoUpstream                      string
+	RangeDiffNoReflogEntries                 string
+	RangeDiffAgainstHead                     string
+	RangeDiffAgainstHeadTooltip              string
+	RangeDiffNoCommits                       string
+	RangeDiffIdenticalPair                   string
+	RangeDiffRemovedPair                     string
+	RangeDiffAddedPair                       string
+	ComparingRangesStatus                    string
+	ExportPatchSeries                        string
+	ExportPatchSeriesTooltip                 string
+	ExportPatches                            string
+	ExportPatchesWithCoverLetter             string
+	ExportPatchesWithCoverLetterTooltip      string
+	PatchOutputDirectoryPrompt               string
+	ExportingPatchesStatus                   string
+	PatchesExportedToast                     string
+	CannotExportTodoCommits                  string
+	ApplyMailbox                             string
+	ApplyMailboxTooltip                      string
+	MailboxPathPrompt                        string
+	CannotApplyMailboxWhile                  string
+	ViewRerereOptions                        string
+	ViewRerereOptionsTooltip                 string
+	RerereNotEnabled                         string
+	RerereResolvedFilesToast                 string
+	RerereForget                             string
+	RerereForgetTooltip                      string
+	RerereForgetPrompt                       string
+	RerereFileNotResolvedByRerere            string
+	RerereViewResolutions                    string
+	RerereViewResolutionsTooltip             string
+	RerereResolutionsTitle                   string
+	RerereResolutionTitle                    string
+	RerereResolved                           string
+	RerereUnresolved                         string
+	RerereNotResolvedYet                     string
+	RerereNoResolutions                      string
+	RerereDeleteResolution                   string
+	RerereDeleteResolutionTooltip            string
+	RerereDeleteResolutionPrompt             string
+	LoadingRerereResolutionsStatus           string
+	AllowedSignersFileMissing                string
+	RepoHealthTitle                          string
+	ShowRepoHealth                           string
+	ShowRepoHealthTooltip                    string
+	RepoMaintenance                          string
+	RepoMaintenanceTooltip                   string
+	CheckingRepoHealth                       string
+	RepoHealthLooseObjects                   string
+	RepoHealthPackedObjects                  string
+	RepoHealthPackedObjectsValue             string
+	RepoHealthPrunableObjects                string
+	RepoHealthGarbage                        string
+	RepoHealthTooManyLooseObjects            string
+	RepoHealthCommitGraph                    string
+	RepoHealthPresent                        string
+	RepoHealthCommitGraphMissing             string
+	RepoHealthMaintenance                    string
+	RepoHealthRegistered                     string
+	RepoHealthNotRegistered                  string
+	RepoHealthStaleWorktrees                 string
+	RepoHealthGoneUpstreams                  string
+	RepoHealthLargeUntrackedFiles            string
+	RepoHealthNone                           string
+	RepoHealthMaintenanceHint                string
+	MaintenanceGc                            string
+	MaintenanceRunTask                       string
+	MaintenancePruneWorktrees                string
+	MaintenancePruneRemotes                  string
+	MaintenanceRegister                      string
+	MaintenanceUnregister                    string
+	RunningMaintenanceStatus                 string
+	ViewCommitTestOptions                    string
+	ViewCommitTestOptionsTooltip             string
+	CommitTestsMenuTitle                     string
+	TestSelectedCommits                      string
+	TestSelectedCommitsTooltip               string
+	RetestSelectedCommits                    string
+	RetestSelectedCommitsTooltip             string
+	TestSelectedCommitsWithCommand           string
+	ClearCommitTestResults                   string
+	ClearCommitTestResultsTooltip            string
+	NoCommitTestResults                      string
+	CannotTestTodoCommits                    string
+	CommitTestCommandPrompt                  string
+	TestingCommitsStatus                     string
+	CommitTestsDoneToast                     string
+	CommitTestOutputTitle                    string
+	ViewRebaseTodoOptions                    string
+	ViewRebaseTodoOptionsTooltip             string
+	RebaseTodoOptionsTitle                   string
+	RebaseTodoOptionsRequireRebase           string
+	InsertExecTodo                           string
+	InsertExecTodoTooltip                    string
+	InsertLabelTodo                          string
+	InsertLabelTodoTooltip                   string
+	InsertResetTodo                          string
+	InsertResetTodoTooltip                   string
+	InsertMergeTodo                          string
+	InsertMergeTodoTooltip                   string
+	EditRebaseTodo                           string
+	EditRebaseTodoTooltip                    string
+	RemoveRebaseTodo                         string
+	CannotInsertTodoHere                     string
+	CannotEditThisTodo                       string
+	ExecTodoPrompt                           string
+	LabelTodoPrompt                          string
+	ResetTodoPrompt                          string
+	MergeTodoPrompt                          string
+	InvalidTodoLabel                         string
+	LabelTodoHere                            string
+	ResetTodoHere                            string
+	MergeTodoHere                            string
+	BranchStackPosition                      string
+	BranchStackBehindBase                    string
+	ViewStackOptions                         string
+	ViewStackOptionsTooltip                  string
+	BranchStackTitle                         string
+	NotInBranchStack                         string
+	BranchStackAhead                         string
+	BranchStackNotPushed                     string
+	BranchStackUpToDate                      string
+	RestackBranches                          string
+	RestackBranchesTooltip                   string
+	PushBranchStack                          string
+	PushBranchStackTooltip                   string
+	NoRemotesToPushStackTo                   string
+	InsertBranchIntoStack                    string
+	InsertBranchIntoStackTooltip             string
+	InsertBranchIntoStackFromCommitsPanel    string
+	CannotInsertBranchAtCommit               string
+	CommitIsAlreadyBranchHead                string
+	InsertBranchIntoStackPrompt              string
+	AbsorbStagedChanges                      string
+	AbsorbStagedChangesTooltip               string
+	AbsorbStagedChangesTitle                 string
+	AbsorbCreateFixupCommits                 string
+	AbsorbCreateFixupCommitsAndSquash        string
+	AbsorbUnassignedHunksStayStaged          string
+	NoStagedChangesToAbsorb                  string
+	NoHunksToAbsorb                          string
+	AbsorbFileWithoutHunks                   string
+	AbsorbHunkInNewFile                      string
+	AbsorbMultipleBaseCommits                string
+	CreatingFixupCommitsStatus               string
+	FilterByUnstagedLinesWithStagedChanges   string
+	CancelCommitGeneration                   string
+	RegeneratedCommitsChanged                string
+	PreCommitNotInstalled                    string
+	CommitTestsRunningTitle                  string
+	CancelCommitTests                        string
+	CommitTestsCancelled                     string
+	RangeDiffTitle                           string
+	GeneratedSummaryTooLong                  string
 }
 
 type Bisect struct {
@@ -941,6 +1292,19 @@ type Bisect struct {
 	CompletePrompt              string
 	CompletePromptIndeterminate string
 	Bisecting                   string
+	RunCommand                  string
+	RunCommandTooltip           string
+	RunRequiresBisecting        string
+	RunPrompt                   string
+	RunTitle                    string
+	RunningStatus               string
+	RunCancelled                string
+	CancelRun                   string
+	ShowRunOutput               string
+	RunWaitingForOutput         string
+	RunFoundCommit              string
+	RunFailedTitle              string
+	RunFailed                   string
 }
 
 type Log struct {
@@ -1076,6 +1440,42 @@ type Actions struct {
 	BisectSkip                       string
 	BisectMark                       string
 	AddWorktree                      string
+	RegenerateCommitMessages         string
+	EditCommitTrailers               string
+	RunPreCommitHooks                string
+	StageFilesModifiedByHooks        string
+	SetNote                          string
+	RemoveNote                       string
+	PushNotes                        string
+	FetchNotes                       string
+	LfsLockFile                      string
+	LfsUnlockFile                    string
+	FetchLfsObjects                  string
+	EnableSparseCheckout             string
+	DisableSparseCheckout            string
+	AddToSparseCheckout              string
+	RemoveFromSparseCheckout         string
+	SetSparseCheckoutMode            string
+	ExportPatches                    string
+	ApplyMailbox                     string
+	RerereForget                     string
+	RerereDeleteResolution           string
+	Gc                               string
+	RunMaintenanceTask               string
+	PruneWorktrees                   string
+	PruneRemote                      string
+	RegisterMaintenance              string
+	UnregisterMaintenance            string
+	BisectRun                        string
+	TestCommits                      string
+	ClearCommitTestResults           string
+	InsertRebaseTodo                 string
+	EditRebaseTodo                   string
+	RemoveRebaseTodo                 string
+	RestackBranches                  string
+	PushBranchStack                  string
+	InsertBranchIntoStack            string
+	AbsorbStagedChanges              string
 }
 
 const englishIntroPopupMessage = `
@@ -1426,6 +1826,7 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewRebaseOptions:                    "View rebase options",
 		ViewCherryPickOptions:                "View cherry-pick options",
 		ViewRevertOptions:                    "View revert options",
+		ViewApplyPatchesOptions:              "View apply patches options",
 		NotMergingOrRebasing:                 "You are currently neither rebasing nor merging",
 		AlreadyRebasing:                      "Can't perform this action during a rebase",
 		NotMidRebase:                         "This action only works during an interactive rebase",
@@ -1435,6 +1836,7 @@ func EnglishTranslationSet() *TranslationSet {
 		RebaseOptionsTitle:                   "Rebase options",
 		CherryPickOptionsTitle:               "Cherry-pick options",
 		RevertOptionsTitle:                   "Revert options",
+		ApplyPatchesOptionsTitle:             "Apply patches options",
 		CommitSummaryTitle:                   "Commit summary",
 		CommitDescriptionTitle:               "Commit description",
 		CommitDescriptionSubTitle:            "Press {{.togglePanelKeyBinding}} to toggle focus, {{.commitMenuKeybinding}} to open menu",
@@ -1523,10 +1925,11 @@ func EnglishTranslationSet() *TranslationSet {
 		MovingStatus:                         "Moving",
 		RebasingStatus:                       "Rebasing",
 		MergingStatus:                        "Merging",
-		LowercaseRebasingStatus:              "rebasing",       // lowercase because it shows up in parentheses
-		LowercaseMergingStatus:               "merging",        // lowercase because it shows up in parentheses
-		LowercaseCherryPickingStatus:         "cherry-picking", // lowercase because it shows up in parentheses
-		LowercaseRevertingStatus:             "reverting",      // lowercase because it shows up in parentheses
+		LowercaseRebasingStatus:              "rebasing",         // lowercase because it shows up in parentheses
+		LowercaseMergingStatus:               "merging",          // lowercase because it shows up in parentheses
+		LowercaseCherryPickingStatus:         "cherry-picking",   // lowercase because it shows up in parentheses
+		LowercaseRevertingStatus:             "reverting",        // lowercase because it shows up in parentheses
+		LowercaseApplyingPatchesStatus:       "applying patches", // lowercase because it shows up in parentheses
 		AmendingStatus:                       "Amending",
 		CherryPickingStatus:                  "Cherry-picking",
 		UndoingStatus:                        "Undoing",
@@ -1535,6 +1938,7 @@ func EnglishTranslationSet() *TranslationSet {
 		CommittingStatus:                     "Committing",
 		RewordingStatus:                      "Rewording",
 		RevertingStatus:                      "Reverting",
+		ApplyingPatchesStatus:                "Applying patches",
 		CreatingFixupCommitStatus:            "Creating fixup commit",
 		MovingCommitsToNewBranchStatus:       "Moving commits to new branch",
 		CommitFiles:                          "Commit files",
@@ -1753,8 +2157,20 @@ func EnglishTranslationSet() *TranslationSet {
 		ExitFilterMode:                   "Stop filtering",
 		FilterPathOption:                 "Enter path to filter by",
 		FilterAuthorOption:               "Enter author to filter by",
+		FilterByLinesOption:              "Filter by lines {{.start}}-{{.end}} of '{{.path}}'",
+		FilterByFunctionOption:           "Filter by function '{{.function}}' in '{{.path}}'",
+		FilterFunctionOption:             "Enter function to filter by in '{{.path}}'",
+		FilterPickaxeOption:              "Search history for a string that commits added or removed (-S)",
+		FilterPickaxeRegexOption:         "Search history for a regex matching lines that commits added or removed (-G)",
+		FilterMessageOption:              "Search history for a pattern in commit messages (--grep)",
+		FilterSearchTooltip:              "Lets git search the whole history rather than only the loaded commits. Combines with the current path or author filter.",
+		FilterByLinesTooltip:             "Show only the commits that touched these lines, following them through the history of the file (git log -L).",
 		EnterFileName:                    "Enter path:",
 		EnterAuthor:                      "Enter author:",
+		EnterFunctionName:                "Enter function name (or regex matching its first line):",
+		EnterPickaxeString:               "Enter string:",
+		EnterPickaxeRegex:                "Enter regex:",
+		EnterMessagePattern:              "Enter message pattern:",
 		FilteringMenuTitle:               "Filtering",
 		WillCancelExistingFilterTooltip:  "Note: this will cancel the existing filter",
 		MustExitFilterModeTitle:          "Command not available",
@@ -2022,6 +2438,341 @@ func EnglishTranslationSet() *TranslationSet {
 		UseCurrentChanges:                        "Use current changes",
 		UseIncomingChanges:                       "Use incoming changes",
 		UseBothChanges:                           "Use both",
+		CommitGeneratorMenuTitle:                 "Generate commit message with",
+		GeneratingCommitMessageStatus:            "Generating commit message ({{.key}} to cancel)",
+		NoStagedChangesToGenerateFrom:            "There are no staged changes to generate a commit message from",
+		CommitGeneratorEmptyMessage:              "The commit message generator returned an empty message",
+		CommitGeneratorTimedOut:                  "Commit message generator '{{.name}}' timed out after {{.seconds}}s",
+		CommitGenerationInProgress:               "A commit message is already being generated",
+		CommitGenerationCancelled:                "Commit message generation cancelled",
+		GenerateCommitMessage:                    "Generate commit message",
+		CommandDoesNotSupportGeneratingMessage:   "Generating a message is only available when committing staged changes",
+		RegenerateCommitMessage:                  "Regenerate commit message",
+		RegenerateCommitMessageTooltip:           "Generate a new message for each selected commit from its diff, using the configured commit message generator. You get to review each message before the commits are reworded.",
+		GeneratingCommitMessagesStatus:           "Generating commit messages ({{.key}} to cancel)",
+		RegeneratedCommitMessageTitle:            "Regenerated commit message ({{.index}} of {{.count}})",
+		RegeneratedCommitMessagePrompt:           "Reword commit {{.hash}}?\n\nBefore:\n{{.before}}\n\nAfter:\n{{.after}}\n\nPress {{.confirmKey}} to use the new message, or {{.cancelKey}} to keep the old one.",
+		CommitLintNotConventional:                "summary should look like 'type: subject' or 'type(scope): subject'",
+		CommitLintUnknownType:                    "unknown type '{{.type}}'",
+		CommitLintUnknownScope:                   "unknown scope '{{.scope}}'",
+		CommitLintSummaryTooLong:                 "summary is {{.length}} characters long (max {{.max}})",
+		CommitLintNotImperative:                  "use the imperative mood ('{{.word}}')",
+		CommitLintMissingTrailer:                 "missing '{{.key}}' trailer",
+		CommitLintTrailerMismatch:                "'{{.key}}' trailer doesn't match '{{.pattern}}'",
+		CommitLintViolationsSubtitle:             "✗ {{.first}} (+{{.more}} more)",
+		CommitLintViolationsTitle:                "Commit message problems",
+		CommitLintViolationsPrompt:               "The commit message breaks these rules:\n\n{{.violations}}\n\nCommit anyway?",
+		EditTrailers:                             "Edit trailers",
+		EditTrailersTooltip:                      "Add, edit or remove trailers such as Co-authored-by, Reviewed-by or Fixes, using git interpret-trailers. When several commits are selected, the change is applied to each of them.",
+		TrailersMenuTitle:                        "Trailers",
+		AddTrailer:                               "Add trailer",
+		OtherTrailerKey:                          "Other key...",
+		TrailerKeyPromptTitle:                    "Trailer key",
+		TrailerValuePromptTitle:                  "Value for '{{.key}}'",
+		EditTrailerValue:                         "Edit value",
+		RemoveTrailer:                            "Remove",
+		InvalidTrailerKey:                        "A trailer key may only contain letters, digits and dashes",
+		TrailerValueMustNotBeEmpty:               "A trailer value must not be empty",
+		CommitAndPush:                            "Commit and push",
+		CommitAndPushSummaryTitle:                "Commit and push",
+		CommitPushStepCommitted:                  "Committed '{{.summary}}'",
+		CommitPushStepNothingCommitted:           "Nothing committed, because the commit message is empty",
+		CommitPushStepCommitFailed:               "Committing failed, so nothing was pushed",
+		CommitPushStepPushed:                     "Pushed to {{.upstream}}",
+		CommitPushStepPushedAndSetUpstream:       "Pushed to {{.upstream}} and made it the upstream branch",
+		CommitPushStepPushFailed:                 "Pushing to {{.upstream}} failed",
+		CommitPushStepRanCustomPushCommand:       "Ran the custom push command",
+		CommitPushStepCustomPushCommandFailed:    "The custom push command failed",
+		CommitPushStepPushRejected:               "Pushing to {{.upstream}} was rejected, because it has commits that you don't have",
+		CommitPushStepPulled:                     "Pulled with --rebase",
+		CommitPushStepPullFailed:                 "Pulling with --rebase failed; resolve the problem and push again",
+		CommitPushStepNotPulled:                  "Did not pull, so nothing was pushed",
+		PushRejectedTitle:                        "Push rejected",
+		PullRebaseAndRetryPrompt:                 "The remote branch has commits that you don't have. Do you want to pull them with --rebase and push again?",
+		GitCommitTemplate:                        "Git commit template (commit.template)",
+		CommitTemplatesMenuTitle:                 "Commit message templates",
+		NoCommitTemplates:                        "There are no commit message templates. Add some to git.commit.templates in your config, or set git's commit.template.",
+		CommitMessageHistoryTitle:                "Recent commit messages",
+		NoCommitMessagesInHistory:                "There are no commit messages to pick from",
+		InsertCommitTemplate:                     "Insert template",
+		InsertCommitTemplateTooltip:              "Replace the commit message with one of the configured templates, with placeholders like the branch name or ticket ID filled in.",
+		PickCommitMessageFromHistory:             "Pick message from history",
+		PickCommitMessageFromHistoryTooltip:      "Replace the commit message with one of the most recent commit messages from all branches. Press '/' in the menu to search them.",
+		ReplaceCommitMessageTitle:                "Replace commit message",
+		SureReplaceCommitMessage:                 "Are you sure you want to replace the current commit message?",
+		NoPreCommitHooks:                         "This repo has no pre-commit hooks: there is neither a .pre-commit-config.yaml nor an executable pre-commit script in the hooks directory",
+		NoStagedFilesToRunHooksOn:                "There are no staged files to run the pre-commit hooks against",
+		RunningPreCommitHooksStatus:              "Running pre-commit hooks",
+		RunPreCommitHooks:                        "Run pre-commit hooks",
+		RunPreCommitHooksTooltip:                 "Run the repo's pre-commit hooks (from .pre-commit-config.yaml, or the pre-commit script in core.hooksPath or .git/hooks) against the staged files without committing, and show the result of each hook.",
+		PreCommitHookResultsTitle:                "Pre-commit hook results",
+		StageFilesModifiedByHooks:                "Stage files modified by hooks",
+		NoFilesModifiedByHooks:                   "The hooks didn't modify any staged files",
+		SkipHooksForNextCommit:                   "Skip hooks when committing",
+		SkipHooksForNextCommitTooltip:            "Put '{{.prefix}}' (git.skipHookPrefix) in front of the message in the commit panel, so that committing it skips the pre-commit hooks.",
+		SkipHookPrefixNotConfigured:              "git.skipHookPrefix is not configured",
+		BlameDynamicTitle:                        "Blame: %s",
+		OpenBlame:                                "Blame file",
+		OpenBlameTooltip:                         "Show the commit in which each line of the selected file was last changed, together with its author and age.",
+		LoadingBlame:                             "Loading blame",
+		BlameGoToCommit:                          "Go to commit",
+		BlameGoToCommitTooltip:                   "Select the commit in which the selected line was last changed in the commits panel.",
+		BlameParentCommit:                        "Blame parent commit",
+		BlameParentCommitTooltip:                 "Blame the file as of the parent of the commit in which the selected line was last changed, to find out where the line came from.",
+		BlameToggleIgnoreWhitespace:              "Toggle ignoring whitespace (-w)",
+		BlameToggleIgnoreWhitespaceTooltip:       "Ignore whitespace changes when looking for the commit in which a line was last changed.",
+		BlameToggleDetectMoves:                   "Toggle detecting moved lines (-M)",
+		BlameToggleDetectMovesTooltip:            "Attribute lines that were moved or copied within the file to the commit that originally added them, rather than the commit that moved them.",
+		BlameToggleDetectCopies:                  "Toggle detecting copied lines (-C)",
+		BlameToggleDetectCopiesTooltip:           "Attribute lines that were moved or copied from other files that were modified in the same commit to the commit that originally added them.",
+		ExitBlame:                                "Exit blame",
+		ExitBlameTooltip:                         "Go back to the previous blame if you blamed a parent commit, or close the blame view.",
+		BlameLineNotCommitted:                    "The selected line hasn't been committed yet",
+		BlameLineHasNoParent:                     "The selected line was added together with the file, so there is no earlier version to blame",
+		NoHistoryToBlame:                         "The selected file has no committed history to blame",
+		CannotBlameDeletedFile:                   "The selected file doesn't exist in this commit",
+		CommitNotInCommitsPanel:                  "Couldn't find the commit in the commits panel",
+		CannotBlameDirectory:                     "Only files can be blamed, not directories",
+		ViewNotesOptions:                         "View notes options",
+		ViewNotesOptionsTooltip:                  "Add, edit or remove the git note of the selected commit, or push or fetch notes to or from a remote.",
+		NotesMenuTitle:                           "Notes ({{.notesRef}})",
+		SelectNotesRef:                           "Select notes ref",
+		AddNote:                                  "Add note",
+		EditNote:                                 "Edit note",
+		AddNoteTooltip:                           "Add a note to the selected commit, or edit the one it has. Notes annotate a commit without changing its hash.",
+		RemoveNote:                               "Remove note",
+		RemoveNoteTooltip:                        "Remove the note of the selected commit.",
+		RemoveNoteTitle:                          "Remove note",
+		RemoveNotePrompt:                         "Are you sure you want to remove the note of commit {{.commitHash}} from {{.notesRef}}?",
+		CommitHasNoNote:                          "The selected commit has no note in {{.notesRef}}.",
+		PushNotes:                                "Push notes to remote",
+		PushNotesTooltip:                         "Push the notes ref to a remote, so that others can see the notes.",
+		PushNotesTitle:                           "Push {{.notesRef}} to remote:",
+		FetchNotes:                               "Fetch notes from remote",
+		FetchNotesTooltip:                        "Fetch the notes ref from a remote. This fails if the local notes have diverged from the remote ones.",
+		FetchNotesTitle:                          "Fetch {{.notesRef}} from remote:",
+		NoteSummaryTitle:                         "Note",
+		NoteDescriptionTitle:                     "Note (continued)",
+		CannotEditNotesOfTodo:                    "Notes can only be added to commits that have been rebased already.",
+		SavingNoteStatus:                         "Saving note",
+		LfsObjectDiffTitle:                       "This file is tracked by Git LFS; showing its LFS objects rather than a diff of its pointer files.",
+		LfsObjectUnchanged:                       "The LFS object is unchanged.",
+		LfsNotAPointer:                           "(content is stored in git directly, not in LFS)",
+		ViewLfsOptions:                           "View Git LFS options",
+		ViewLfsOptionsTooltip:                    "Lock or unlock the selected file on the Git LFS server, or list all locks.",
+		LfsLockFile:                              "Lock file",
+		LfsLockFileTooltip:                       "Lock the selected file on the Git LFS server, so that nobody else can push changes to it until you unlock it.",
+		LfsUnlockFile:                            "Unlock file",
+		LfsUnlockFileTooltip:                     "Release your Git LFS lock of the selected file.",
+		LfsListLocks:                             "List locks",
+		LfsListLocksTooltip:                      "Show all locks on the Git LFS server. Selecting one releases it.",
+		LfsLocksTitle:                            "Git LFS locks",
+		LfsNoLocks:                               "There are no Git LFS locks.",
+		LfsForceUnlockPrompt:                     "Release the lock of {{.path}} held by {{.owner}}? If it isn't your lock, this forces it to be released.",
+		LockingFileStatus:                        "Locking file",
+		UnlockingFileStatus:                      "Unlocking file",
+		LoadingLfsLocks:                          "Loading Git LFS locks",
+		CannotLockDirectory:                      "Only files can be locked.",
+		FetchLfsObjects:                          "Fetch LFS objects",
+		FetchLfsObjectsTooltip:                   "Download the Git LFS objects of the selected commits from a remote, so that they can be checked out offline.",
+		FetchLfsObjectsTitle:                     "Fetch LFS objects from remote:",
+		FetchingLfsObjectsStatus:                 "Fetching LFS objects",
+		ViewSparseCheckoutOptions:                "View sparse checkout options",
+		ViewSparseCheckoutOptionsTooltip:         "Manage which directories of the repository are checked out in the working tree, using git sparse-checkout.",
+		SparseCheckoutConeModeTitle:              "Sparse checkout (cone mode)",
+		SparseCheckoutNonConeModeTitle:           "Sparse checkout (non-cone mode)",
+		SparseCheckoutDirectories:                "Checked out directories",
+		SparseCheckoutPatterns:                   "Patterns",
+		SparseCheckoutOnlyTopLevelFiles:          "Only the files at the top level are checked out.",
+		SparseCheckoutActions:                    "Actions",
+		SparseCheckoutRemoveTooltip:              "Remove this from the sparse checkout, which removes its files from the working tree.",
+		SparseCheckoutRemoveTitle:                "Remove from sparse checkout",
+		SparseCheckoutRemovePrompt:               "Remove '{{.pattern}}' from the sparse checkout? Its files will be removed from the working tree.",
+		SparseCheckoutPickDirectories:            "Pick directories",
+		SparseCheckoutPickDirectoriesTooltip:     "Show the directories of the repository as a tree, with whether they are checked out, and add or remove them.",
+		SparseCheckoutPickDirectoryTitle:         "Add or remove directory",
+		SparseCheckoutNotCheckedOut:              "not checked out",
+		SparseCheckoutPartiallyCheckedOut:        "partially checked out",
+		SparseCheckoutIncludedByParent:           "This directory is checked out because '{{.dir}}' is in the sparse checkout.",
+		SparseCheckoutSwitchToConeMode:           "Switch to cone mode",
+		SparseCheckoutSwitchToConeModeTooltip:    "Use directories rather than gitignore-style patterns to define the sparse checkout. This fails if the current patterns can't be expressed as directories.",
+		SparseCheckoutSwitchToNonConeMode:        "Switch to non-cone mode",
+		SparseCheckoutSwitchToNonConeModeTooltip: "Use gitignore-style patterns rather than directories to define the sparse checkout.",
+		SparseCheckoutDisable:                    "Disable sparse checkout",
+		SparseCheckoutDisableTooltip:             "Check out all files of the repository again.",
+		SparseCheckoutEnableConeMode:             "Enable sparse checkout in cone mode",
+		SparseCheckoutEnableConeModeTooltip:      "Check out only the files at the top level of the repository; you can then add the directories that you need.",
+		SparseCheckoutEnableNonConeMode:          "Enable sparse checkout in non-cone mode",
+		SparseCheckoutEnableNonConeModeTooltip:   "Check out only the files at the top level of the repository; you can then add gitignore-style patterns for the files that you need.",
+		UpdatingSparseCheckoutStatus:             "Updating sparse checkout",
+		LoadingTrackedFilesStatus:                "Loading files",
+		RangeDiffDynamicTitle:                    "Range diff (%s)",
+		ViewRangeDiffOptions:                     "View range-diff options",
+		ViewRangeDiffOptionsTooltip:              "Compare two versions of a series of commits, e.g. before and after an interactive rebase, using git range-diff.",
+		RangeDiffUpstreamVsLocal:                 "Upstream vs. local branch",
+		RangeDiffUpstreamVsLocalTooltip:          "Compare the commits that the upstream branch has on top of the merge base with those of the local branch, e.g. to review what you are about to force-push.",
+		RangeDiffReflogEntryVsHead:               "Reflog entry vs. HEAD",
+		RangeDiffReflogEntryVsHeadTooltip:        "Compare the commits of HEAD as of an earlier reflog entry with the current ones, e.g. to see what an interactive rebase changed.",
+		RangeDiffEnterRanges:                     "Enter ranges",
+		RangeDiffEnterRangesTooltip:              "Compare two arbitrary commit ranges, e.g. main..feature@{1} and main..feature.",
+		RangeDiffOldRangePrompt:                  "Old range:",
+		RangeDiffNewRangePrompt:                  "New range:",
+		RangeDiffPickReflogEntryTitle:            "Compare HEAD with reflog entry",
+		RangeDiffNoUpstream:                      "The checked-out branch has no upstream (or the upstream is not stored locally)",
+		RangeDiffNoReflogEntries:                 "There are no earlier reflog entries",
+		RangeDiffAgainstHead:                     "Range-diff against HEAD",
+		RangeDiffAgainstHeadTooltip:              "Compare the commits of HEAD as of the selected reflog entry with the current ones using git range-diff, e.g. to see what an interactive rebase changed.",
+		RangeDiffNoCommits:                       "There are no commits to compare",
+		RangeDiffIdenticalPair:                   "The two commits have the same patch.",
+		RangeDiffRemovedPair:                     "This commit only exists in the old range.",
+		RangeDiffAddedPair:                       "This commit only exists in the new range.",
+		ComparingRangesStatus:                    "Comparing ranges",
+		ExportPatchSeries:                        "Export as patch series",
+		ExportPatchSeriesTooltip:                 "Export the selected commits as a numbered series of patch files (git format-patch), e.g. for sending them by email.",
+		ExportPatches:                            "Export patches",
+		ExportPatchesWithCoverLetter:             "Export patches with cover letter",
+		ExportPatchesWithCoverLetterTooltip:      "Also create a cover letter (0000-cover-letter.patch) to introduce the series.",
+		PatchOutputDirectoryPrompt:               "Output directory:",
+		ExportingPatchesStatus:                   "Exporting patches",
+		PatchesExportedToast:                     "Exported {{.count}} patch file(s) to {{.dir}}",
+		CannotExportTodoCommits:                  "Can't export rebase todo items",
+		ApplyMailbox:                             "Apply patches from mailbox",
+		ApplyMailboxTooltip:                      "Apply the patches in an mbox or patch file as commits on top of HEAD (git am -3). If a patch doesn't apply cleanly, you can resolve the conflicts and continue, skip, or abort from the merge/rebase options.",
+		MailboxPathPrompt:                        "Path of mbox or patch file:",
+		CannotApplyMailboxWhile:                  "Can't apply patches while {{.operation}}",
+		ViewRerereOptions:                        "View rerere options",
+		ViewRerereOptionsTooltip:                 "Rerere (reuse recorded resolution) makes git remember how you resolved conflicts, and resolve them the same way when they come up again. View options for forgetting a recorded resolution and for viewing all recorded resolutions.",
+		RerereNotEnabled:                         "Rerere isn't enabled. Set rerere.enabled to true in your git config to make git remember how you resolved conflicts.",
+		RerereResolvedFilesToast:                 "Rerere resolved {{.count}} conflicted file(s) using recorded resolutions",
+		RerereForget:                             "Forget recorded resolution",
+		RerereForgetTooltip:                      "Make rerere forget how it resolved the conflicts of the selected file, and bring back the conflict markers so that you can resolve them again. Use this if rerere reused a wrong resolution.",
+		RerereForgetPrompt:                       "Are you sure you want to forget the recorded resolution of '{{.path}}'? Its conflict markers will be brought back, discarding any changes that you made to the file.",
+		RerereFileNotResolvedByRerere:            "The selected file wasn't resolved by rerere",
+		RerereViewResolutions:                    "View recorded resolutions",
+		RerereViewResolutionsTooltip:             "List the conflicts that rerere recorded, and show how they were resolved.",
+		RerereResolutionsTitle:                   "Recorded resolutions",
+		RerereResolutionTitle:                    "Recorded resolution",
+		RerereResolved:                           "resolved",
+		RerereUnresolved:                         "unresolved",
+		RerereNotResolvedYet:                     "Rerere recorded this conflict, but not its resolution yet.",
+		RerereNoResolutions:                      "Rerere hasn't recorded any conflicts",
+		RerereDeleteResolution:                   "Delete recorded resolution",
+		RerereDeleteResolutionTooltip:            "Delete the recorded conflict and its resolution, so that rerere won't resolve this conflict anymore.",
+		RerereDeleteResolutionPrompt:             "Are you sure you want to delete the selected recorded resolution(s)?",
+		LoadingRerereResolutionsStatus:           "Loading recorded resolutions",
+		AllowedSignersFileMissing:                "gpg.ssh.allowedSignersFile isn't configured, so git can only check that the signature matches the key it was made with, not who the key belongs to.",
+		RepoHealthTitle:                          "Repository health",
+		ShowRepoHealth:                           "Show repository health",
+		ShowRepoHealthTooltip:                    "Show statistics and problems that tell whether the repository needs maintenance, such as the number of loose objects, stale worktrees and branches whose upstream is gone.",
+		RepoMaintenance:                          "Repository maintenance",
+		RepoMaintenanceTooltip:                   "Run git's housekeeping commands, e.g. garbage collection or pruning stale worktrees and remote-tracking branches.",
+		CheckingRepoHealth:                       "Checking repository health...",
+		RepoHealthLooseObjects:                   "Loose objects",
+		RepoHealthPackedObjects:                  "Packed objects",
+		RepoHealthPackedObjectsValue:             "{{.count}} in {{.packs}} pack(s), {{.size}}",
+		RepoHealthPrunableObjects:                "Loose objects that are also packed",
+		RepoHealthGarbage:                        "Garbage files",
+		RepoHealthTooManyLooseObjects:            "git gc would pack these",
+		RepoHealthCommitGraph:                    "Commit-graph",
+		RepoHealthPresent:                        "present",
+		RepoHealthCommitGraphMissing:             "missing, so walking the history is slower than it could be",
+		RepoHealthMaintenance:                    "Background maintenance",
+		RepoHealthRegistered:                     "registered",
+		RepoHealthNotRegistered:                  "not registered",
+		RepoHealthStaleWorktrees:                 "Stale worktrees",
+		RepoHealthGoneUpstreams:                  "Branches whose upstream is gone",
+		RepoHealthLargeUntrackedFiles:            "Large untracked files",
+		RepoHealthNone:                           "none",
+		RepoHealthMaintenanceHint:                "Press {{.key}} for maintenance actions.",
+		MaintenanceGc:                            "Collect garbage and repack (git gc)",
+		MaintenanceRunTask:                       "Run the {{.task}} maintenance task",
+		MaintenancePruneWorktrees:                "Prune stale worktrees (git worktree prune)",
+		MaintenancePruneRemotes:                  "Prune remote-tracking branches of deleted branches (git remote prune)",
+		MaintenanceRegister:                      "Register for background maintenance (git maintenance register)",
+		MaintenanceUnregister:                    "Unregister from background maintenance (git maintenance unregister)",
+		RunningMaintenanceStatus:                 "Running maintenance",
+		ViewCommitTestOptions:                    "View commit test options",
+		ViewCommitTestOptionsTooltip:             "Run a shell command on each selected commit in a temporary worktree, e.g. to check that every commit builds before pushing.",
+		CommitTestsMenuTitle:                     "Test commits",
+		TestSelectedCommits:                      "Test selected commits",
+		TestSelectedCommitsTooltip:               "Run the test command on each selected commit in a temporary worktree. Commits whose tree has already been tested with this command are skipped.",
+		RetestSelectedCommits:                    "Test selected commits again",
+		RetestSelectedCommitsTooltip:             "Run the test command on each selected commit, even if its tree has already been tested with it.",
+		TestSelectedCommitsWithCommand:           "Test selected commits with another command",
+		ClearCommitTestResults:                   "Clear test results",
+		ClearCommitTestResultsTooltip:            "Forget the results of the current test command, so that the next run tests all commits again.",
+		NoCommitTestResults:                      "There are no test results to clear.",
+		CannotTestTodoCommits:                    "Commits that are still to be rebased can't be tested.",
+		CommitTestCommandPrompt:                  "Command to test each commit with:",
+		TestingCommitsStatus:                     "Testing commits",
+		CommitTestsDoneToast:                     "Tested commits: {{.passed}} passed, {{.failed}} failed",
+		CommitTestOutputTitle:                    "Test output",
+		ViewRebaseTodoOptions:                    "View rebase todo options",
+		ViewRebaseTodoOptionsTooltip:             "Insert exec, label, reset, or merge todos after the selected commit, or edit or remove such todos. Only available during an interactive rebase.",
+		RebaseTodoOptionsTitle:                   "Rebase todo options",
+		RebaseTodoOptionsRequireRebase:           "Only available during an interactive rebase",
+		InsertExecTodo:                           "Insert exec todo after selected commit",
+		InsertExecTodoTooltip:                    "Run a shell command after the selected commit has been applied. The rebase stops if the command fails.",
+		InsertLabelTodo:                          "Insert label todo after selected commit",
+		InsertLabelTodoTooltip:                   "Give the commit that HEAD points to at this point of the rebase a name, so that later reset and merge todos can refer to it.",
+		InsertResetTodo:                          "Insert reset todo after selected commit",
+		InsertResetTodoTooltip:                   "Reset HEAD to a label (or any other revision) at this point of the rebase, e.g. to start rebuilding a side branch.",
+		InsertMergeTodo:                          "Insert merge todo after selected commit",
+		InsertMergeTodoTooltip:                   "Create a merge commit at this point of the rebase that merges the given label (or any other revision) into HEAD.",
+		EditRebaseTodo:                           "Edit todo",
+		EditRebaseTodoTooltip:                    "Change the command of an exec todo, or the label of a label, reset, or merge todo.",
+		RemoveRebaseTodo:                         "Remove todo",
+		CannotInsertTodoHere:                     "Todos can only be inserted after a todo or after the current commit",
+		CannotEditThisTodo:                       "Only exec, label, reset, and merge todos can be edited here",
+		ExecTodoPrompt:                           "Command to execute:",
+		LabelTodoPrompt:                          "Label name:",
+		ResetTodoPrompt:                          "Reset to label:",
+		MergeTodoPrompt:                          "Label to merge:",
+		InvalidTodoLabel:                         "Labels can't be empty or contain whitespace",
+		LabelTodoHere:                            "Label the current commit as '{{.label}}' here",
+		ResetTodoHere:                            "Reset HEAD to '{{.label}}' here",
+		MergeTodoHere:                            "Create a merge commit that merges '{{.label}}' here",
+		BranchStackPosition:                      "stack %d/%d",
+		BranchStackBehindBase:                    "%d behind base",
+		ViewStackOptions:                         "View branch stack options",
+		ViewStackOptionsTooltip:                  "View the stack of branches that the checked-out branch is part of, i.e. the chain of local branches that are each based on the previous one, and actions for restacking, pushing, and extending it.",
+		BranchStackTitle:                         "Branch stack",
+		NotInBranchStack:                         "The checked-out branch is not part of a stack of branches",
+		BranchStackAhead:                         "%d ahead",
+		BranchStackNotPushed:                     "not pushed",
+		BranchStackUpToDate:                      "All branches of the stack are up to date with their base",
+		RestackBranches:                          "Restack onto updated bases",
+		RestackBranchesTooltip:                   "Rebase the branches of the stack whose base has moved on, e.g. because it was amended or rebased onto an updated main branch, so that each branch is based on the current head of the previous one again. Branches above a restacked branch move along with it.",
+		PushBranchStack:                          "Push whole stack",
+		PushBranchStackTooltip:                   "Push all branches of the stack from the bottom up, using --force-with-lease since restacking rewrites their commits. Branches without an upstream are pushed to a branch of the same name on the 'origin' remote (or the first remote if there is none called 'origin').",
+		NoRemotesToPushStackTo:                   "Can't push branches without an upstream because there are no remotes",
+		InsertBranchIntoStack:                    "Insert new branch at selected commit",
+		InsertBranchIntoStackTooltip:             "Create a new branch pointing at the selected commit, splitting the branch that contains it into two branches of the stack.",
+		InsertBranchIntoStackFromCommitsPanel:    "Open this menu from the commits panel to select the commit to insert a branch at",
+		CannotInsertBranchAtCommit:               "A branch can only be inserted at a commit that is part of the stack",
+		CommitIsAlreadyBranchHead:                "A branch already points at the selected commit",
+		InsertBranchIntoStackPrompt:              "New branch name (at %s):",
+		AbsorbStagedChanges:                      "Absorb staged changes into fixup commits",
+		AbsorbStagedChangesTooltip:               "Split the staged changes into hunks and find the commit of the current branch that each of them belongs to, the same way as 'Find base commit for fixup' does for all changes together. After showing which hunk goes into which commit, create a fixup commit for each of these commits, and optionally squash them right away. Hunks that don't belong to a single commit stay staged.",
+		AbsorbStagedChangesTitle:                 "Absorb staged changes",
+		AbsorbCreateFixupCommits:                 "Create fixup commits",
+		AbsorbCreateFixupCommitsAndSquash:        "Create fixup commits and squash them",
+		AbsorbUnassignedHunksStayStaged:          "Hunks without a base commit stay staged.",
+		NoStagedChangesToAbsorb:                  "There are no staged changes to absorb",
+		NoHunksToAbsorb:                          "None of the staged hunks belongs to a single commit of the current branch",
+		AbsorbFileWithoutHunks:                   "binary file or file mode change",
+		AbsorbHunkInNewFile:                      "new file",
+		AbsorbMultipleBaseCommits:                "multiple base commits: %s",
+		CreatingFixupCommitsStatus:               "Creating fixup commits",
+		FilterByUnstagedLinesWithStagedChanges:   "The file also has staged changes, so the line numbers of its unstaged changes don't match the committed file. Select the lines in the staged changes instead, or stage the whole file first.",
+		CancelCommitGeneration:                   "Cancel commit message generation",
+		RegeneratedCommitsChanged:                "The commits were changed while their messages were being generated. Please try again.",
+		PreCommitNotInstalled:                    "This repo's hooks are managed by the pre-commit framework, but the pre-commit executable wasn't found in your PATH",
+		CommitTestsRunningTitle:                  "Testing commits: {{.command}}",
+		CancelCommitTests:                        "Cancel testing commits",
+		CommitTestsCancelled:                     "Testing commits cancelled",
+		RangeDiffTitle:                           "Range Diff",
+		GeneratedSummaryTooLong:                  "The generated summary is {{.length}} characters long, which is more than the {{.max}} of gui.commitLength.max",
 
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
@@ -2146,6 +2897,42 @@ func EnglishTranslationSet() *TranslationSet {
 			BisectSkip:                       "Bisect skip",
 			BisectMark:                       "Bisect mark",
 			AddWorktree:                      "Add worktree",
+			RegenerateCommitMessages:         "Regenerate commit messages",
+			EditCommitTrailers:               "Edit commit trailers",
+			RunPreCommitHooks:                "Run pre-commit hooks",
+			StageFilesModifiedByHooks:        "Stage files modified by hooks",
+			SetNote:                          "Set note",
+			RemoveNote:                       "Remove note",
+			PushNotes:                        "Push notes",
+			FetchNotes:                       "Fetch notes",
+			LfsLockFile:                      "Lock file",
+			LfsUnlockFile:                    "Unlock file",
+			FetchLfsObjects:                  "Fetch LFS objects",
+			EnableSparseCheckout:             "Enable sparse checkout",
+			DisableSparseCheckout:            "Disable sparse checkout",
+			AddToSparseCheckout:              "Add to sparse checkout",
+			RemoveFromSparseCheckout:         "Remove from sparse checkout",
+			SetSparseCheckoutMode:            "Set sparse checkout mode",
+			ExportPatches:                    "Export patches",
+			ApplyMailbox:                     "Apply patches from mailbox",
+			RerereForget:                     "Forget recorded resolution",
+			RerereDeleteResolution:           "Delete recorded resolution",
+			Gc:                               "Collect garbage",
+			RunMaintenanceTask:               "Run maintenance task",
+			PruneWorktrees:                   "Prune worktrees",
+			PruneRemote:                      "Prune remote",
+			RegisterMaintenance:              "Register for maintenance",
+			UnregisterMaintenance:            "Unregister from maintenance",
+			BisectRun:                        "Bisect run",
+			TestCommits:                      "Test commits",
+			ClearCommitTestResults:           "Clear commit test results",
+			InsertRebaseTodo:                 "Insert rebase todo",
+			EditRebaseTodo:                   "Edit rebase todo",
+			RemoveRebaseTodo:                 "Remove rebase todo",
+			RestackBranches:                  "Restack branches",
+			PushBranchStack:                  "Push branch stack",
+			InsertBranchIntoStack:            "Insert branch into stack",
+			AbsorbStagedChanges:              "Absorb staged changes",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -2163,6 +2950,19 @@ func EnglishTranslationSet() *TranslationSet {
 			CompletePrompt:              "Bisect complete! The following commit introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			CompletePromptIndeterminate: "Bisect complete! Some commits were skipped, so any of the following commits may have introduced the change:\n\n%s\n\nDo you want to reset 'git bisect' now?",
 			Bisecting:                   "Bisecting",
+			RunCommand:                  "Run a command to find the bad commit automatically",
+			RunCommandTooltip:           "Test the remaining commits with a shell command, using 'git bisect run'. Exit code 0 marks a commit as good, 125 skips it, and any other code from 1 to 127 marks it as bad. The bisect stops at the first bad commit.",
+			RunRequiresBisecting:        "Mark a good and a bad commit first, so that git knows which commits to test.",
+			RunPrompt:                   "Command to test each commit with:",
+			RunTitle:                    "Bisect run: %s",
+			RunningStatus:               "Running bisect",
+			RunCancelled:                "Bisect run cancelled. You can keep bisecting from where it stopped.",
+			CancelRun:                   "Cancel bisect run",
+			ShowRunOutput:               "Show bisect run output",
+			RunWaitingForOutput:         "Waiting for output...",
+			RunFoundCommit:              "Found the first bad commit: %s",
+			RunFailedTitle:              "Bisect run failed",
+			RunFailed:                   "The bisect run stopped before finding the first bad commit. Its output was:\n\n%s",
 		},
 		Log: Log{
 			EditRebase:               "Beginning interactive rebase at '{{.ref}}'",
@@ -27,6 +27,12 @@ func (self *Git) RemoteTagDeleted(ref string, tagName string) *Git {
 	})
 }
 
+func (self *Git) RemoteRefExists(remote string, refName string) *Git {
+	return self.expect([]string{"git", "ls-remote", remote, refName}, func(s string) (bool, string) {
+		return len(s) > 0, fmt.Sprintf("Expected ref %s to exist in %s", refName, remote)
+	})
+}
+
 func (self *Git) assert(cmdArgs []string, expected string) *Git {
 	self.expect(cmdArgs, func(output string) (bool, string) {
 		return output == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, output)
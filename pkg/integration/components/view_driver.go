@@ -407,6 +407,14 @@ func (self *ViewDriver) IsFocused() *ViewDriver {
 	return self
 }
 
+// Types the given text into an editable view
+func (self *ViewDriver) Type(value string) *ViewDriver {
+	self.IsFocused()
+	self.t.typeContent(value)
+
+	return self
+}
+
 func (self *ViewDriver) Press(keyStr string) *ViewDriver {
 	self.IsFocused()
 
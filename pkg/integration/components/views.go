@@ -42,6 +42,10 @@ func (self *Views) MergeConflicts() *ViewDriver {
 	return self.regularView("mergeConflicts")
 }
 
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Commits() *ViewDriver {
 	return self.regularView("commits")
 }
@@ -50,6 +54,10 @@ func (self *Views) Files() *ViewDriver {
 	return self.regularView("files")
 }
 
+func (self *Views) StagedFiles() *ViewDriver {
+	return self.regularView("stagedFiles")
+}
+
 func (self *Views) Worktrees() *ViewDriver {
 	return self.regularView("worktrees")
 }
@@ -94,6 +102,14 @@ func (self *Views) SubCommits() *ViewDriver {
 	return self.regularView("subCommits")
 }
 
+func (self *Views) RangeDiff() *ViewDriver {
+	return self.regularView("rangeDiff")
+}
+
+func (self *Views) RerereResolutions() *ViewDriver {
+	return self.regularView("rerereResolutions")
+}
+
 func (self *Views) CommitFiles() *ViewDriver {
 	return self.regularView("commitFiles")
 }
@@ -140,6 +156,15 @@ func (self *Views) CommitDescription() *ViewDriver {
 	return self.regularView("commitDescription")
 }
 
+// The inline commit message input in the side panel
+func (self *Views) CommitInput() *ViewDriver {
+	return self.regularView("commitInput")
+}
+
+func (self *Views) CommitPushButton() *ViewDriver {
+	return self.regularView("commitPushButton")
+}
+
 func (self *Views) Suggestions() *ViewDriver {
 	return self.regularView("suggestions")
 }
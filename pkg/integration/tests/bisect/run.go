@@ -0,0 +1,72 @@
+package bisect
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Run = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Let git bisect run find the bad commit by testing each commit with a shell command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateNCommits(10)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Log.ShowGraph = "never"
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 10")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as bad`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				// we can't run a command until git knows which commits to test
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run a command to find the bad commit automatically")).
+					Confirm()
+				t.ExpectToast(Contains("Mark a good and a bad commit first"))
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Cancel()
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).Select(MatchesRegexp(`Mark .* as good`)).Confirm()
+			}).
+			Press(keys.Commits.ViewBisectOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Bisect")).
+					Select(Contains("Run a command to find the bad commit automatically")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to test each commit with:")).
+					Type("test ! -f file07.txt").
+					Confirm()
+
+				t.ExpectToast(Contains("Found the first bad commit"))
+			}).
+			IsFocused().
+			SelectedLine(Contains("commit 07").Contains("<-- bad")).
+			Lines(
+				Contains("commit 10"),
+				Contains("commit 09"),
+				Contains("commit 08"),
+				Contains("commit 07").Contains("<-- bad"),
+				Contains("commit 06"),
+				Contains("commit 05").Contains("<-- good"),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01").Contains("<-- good"),
+			)
+
+		t.Views().Main().Content(Contains("file07.txt"))
+		t.Views().Information().Content(Contains("Bisecting"))
+	},
+})
@@ -0,0 +1,67 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StackPush = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push all branches of a stack, force-pushing the ones that were rewritten and setting the upstream of the ones that were never pushed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("master 1").
+			CloneIntoRemote("origin").
+			SetBranchUpstream("master", "origin/master").
+			NewBranch("feature-a").
+			EmptyCommit("a 1").
+			PushBranchAndSetUpstream("origin", "feature-a").
+			NewBranch("feature-b").
+			EmptyCommit("b 1").
+			Checkout("feature-a").
+			RunCommand([]string{"git", "commit", "--amend", "--allow-empty", "-m", "a 1 amended"}).
+			Checkout("feature-b")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature-b stack 2/2 1 behind base").IsSelected(),
+				Contains("feature-a ↓1↑1 stack 1/2"),
+				Contains("master ✓"),
+			).
+			Press(keys.Branches.ViewStackOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branch stack")).
+			Select(Contains("Push whole stack")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature-b ✓ stack 2/2 1 behind base"),
+				Contains("feature-a ✓ stack 1/2"),
+				Contains("master ✓"),
+			)
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("feature-a"),
+				Contains("feature-b"),
+				Contains("master"),
+			).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("a 1 amended"),
+				Contains("master 1"),
+			)
+	},
+})
@@ -0,0 +1,94 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StackRestackAndInsertBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restack a stack of branches after its bottom branch got a new commit, then insert a new branch in the middle of the stack",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("master 1").
+			NewBranch("feature-a").
+			EmptyCommit("a 1").
+			EmptyCommit("a 2").
+			NewBranch("feature-b").
+			EmptyCommit("b 1").
+			Checkout("feature-a").
+			EmptyCommit("a 3").
+			Checkout("feature-b")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature-b stack 2/2 1 behind base").IsSelected(),
+				Contains("feature-a stack 1/2"),
+				Contains("master"),
+			).
+			Press(keys.Branches.ViewStackOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branch stack")).
+			Lines(
+				Contains("* feature-b").Contains("1 ahead 1 behind base").Contains("not pushed"),
+				Contains("feature-a").Contains("3 ahead").Contains("not pushed"),
+				Contains("Restack onto updated bases"),
+				Contains("Push whole stack"),
+				Contains("Insert new branch at selected commit"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Restack onto updated bases")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature-b stack 2/2").DoesNotContain("behind base"),
+				Contains("feature-a stack 1/2"),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("b 1").IsSelected(),
+				Contains("a 3"),
+				Contains("a 2"),
+				Contains("a 1"),
+				Contains("master 1"),
+			).
+			NavigateToLine(Contains("a 2")).
+			Press(keys.Commits.ViewStackOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branch stack")).
+			Select(Contains("Insert new branch at selected commit")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("New branch name")).
+			Type("feature-mid").
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature-b stack 3/3"),
+				Contains("feature-a stack 2/3"),
+				Contains("feature-mid stack 1/3"),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("b 1"),
+				Contains("* a 3"),
+				Contains("* a 2").IsSelected(),
+				Contains("a 1"),
+				Contains("master 1"),
+			)
+	},
+})
@@ -0,0 +1,77 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorbs the staged hunks into fixup commits for the commits they belong to, leaving unassigned hunks and unstaged changes alone",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit").
+			NewBranch("mybranch").
+			CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n").
+			Commit("add file1").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n4\n5\n6\n7\n8\n").
+			Commit("change line 2").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n4\n5\n6\nseven\n8\n").
+			CreateFileAndAdd("file2", "a\nb\n").
+			Commit("change line 7 and add file2").
+			UpdateFileAndAdd("file1", "1\nTWO\n3\nFOUR\n5\n6\nSEVEN\n8\n").
+			UpdateFileAndAdd("file2", "a\nb\nc\n").
+			CreateFileAndAdd("file3", "new\n").
+			UpdateFile("file1", "1\nTWO\n3\nFOUR\n5\n6\nSEVEN\neight\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			TopLines(
+				Contains("file1:2").Contains("change line 2"),
+				Contains("file1:4").Contains("add file1"),
+				Contains("file1:7").Contains("change line 7 and add file2"),
+				Contains("file2:3").Contains("change line 7 and add file2"),
+				Contains("file3:1").Contains("new file"),
+			).
+			Select(Equals("f Create fixup commits")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fixup! change line 7 and add file2"),
+				Contains("fixup! change line 2"),
+				Contains("fixup! add file1"),
+				Contains("change line 7 and add file2"),
+				Contains("change line 2"),
+				Contains("add file1"),
+				Contains("initial commit"),
+			).
+			NavigateToLine(Contains("fixup! change line 7 and add file2"))
+
+		t.Views().Main().
+			Content(
+				Contains("-seven").
+					Contains("+SEVEN").
+					Contains("+c").
+					DoesNotContain("+TWO").
+					DoesNotContain("+FOUR").
+					DoesNotContain("eight").
+					DoesNotContain("file3"),
+			)
+
+		// The unstaged change is still there
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("1\nTWO\n3\nFOUR\n5\n6\nSEVEN\neight\n"))
+	},
+})
@@ -0,0 +1,60 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AbsorbStagedChangesAndSquash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorbs the staged hunks into fixup commits and squashes them right away",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit").
+			NewBranch("mybranch").
+			CreateFileAndAdd("file1", "1\n2\n3\n").
+			Commit("add file1").
+			CreateFileAndAdd("file2", "a\nb\nc\n").
+			Commit("add file2").
+			EmptyCommit("unrelated commit").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n").
+			UpdateFileAndAdd("file2", "a\nb\nc\nd\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			TopLines(
+				Contains("file1:2").Contains("add file1"),
+				Contains("file2:4").Contains("add file2"),
+			).
+			Select(Contains("Create fixup commits and squash them")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("unrelated commit").IsSelected(),
+				Contains("add file2"),
+				Contains("add file1"),
+				Contains("initial commit"),
+			).
+			NavigateToLine(Contains("add file2"))
+
+		t.Views().Main().
+			Content(Contains("+a\n+b\n+c\n+d"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("add file1"))
+
+		t.Views().Main().
+			Content(Contains("+1\n+two\n+3"))
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,77 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var rejectFixupForChangeLine2Hook = `#!/bin/sh
+
+if grep -q "fixup! change line 2" "$1"; then
+	echo "hook says no" >&2
+	exit 1
+fi
+`
+
+var AbsorbStagedChangesHookFails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Absorbs staged hunks when a hook rejects one of the fixup commits, keeping the hunks that weren't committed staged",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit").
+			NewBranch("mybranch").
+			CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n").
+			CreateFileAndAdd("file2", "a\n").
+			Commit("add file1").
+			UpdateFileAndAdd("file1", "1\ntwo\n3\n4\n5\n6\n7\n8\n").
+			Commit("change line 2").
+			UpdateFileAndAdd("file1", "1\nTWO\n3\nFOUR\n5\n6\n7\n8\n").
+			MakeExecutable("file2").
+			GitAdd("file2")
+
+		shell.CreateFile(".git/hooks/commit-msg", rejectFixupForChangeLine2Hook)
+		shell.MakeExecutable(".git/hooks/commit-msg")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			TopLines(
+				Contains("file1:2").Contains("change line 2"),
+				Contains("file1:4").Contains("add file1"),
+				Contains("file2").Contains("binary file or file mode change"),
+			).
+			Select(Equals("f Create fixup commits")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("hook says no")).
+			Confirm()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fixup! add file1"),
+				Contains("change line 2"),
+				Contains("add file1"),
+				Contains("initial commit"),
+			)
+
+		// The hunk that wasn't committed and the mode change are still staged
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.AbsorbStagedChanges)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Absorb staged changes")).
+			TopLines(
+				Contains("file1:2").Contains("change line 2"),
+				Contains("file2").Contains("binary file or file mode change"),
+			)
+	},
+})
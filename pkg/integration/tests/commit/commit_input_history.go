@@ -0,0 +1,71 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitInputHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pick a commit message from the recent messages of all branches and use it in the commit input",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial")
+		shell.NewBranch("other")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("Fix resizing of the side panel\n\nIt was too narrow.")
+		shell.Checkout("master")
+		shell.EmptyCommit("Add option")
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Press(keys.CommitMessage.CommitMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Menu")).
+			Select(Contains("Pick message from history")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent commit messages")).
+			ContainsLines(
+				Contains("Add option"),
+			).
+			ContainsLines(
+				Contains("Fix resizing of the side panel"),
+			).
+			ContainsLines(
+				Contains("initial"),
+			).
+			Filter("resiz").
+			Lines(
+				Contains("Fix resizing of the side panel").IsSelected(),
+			).
+			Tooltip(Equals("Fix resizing of the side panel\n\nIt was too narrow.")).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("Fix resizing of the side panel\n\nIt was too narrow.")).
+			PressEnter()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("")).
+			Press(keys.Universal.PrevBlock)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("Fix resizing of the side panel").IsSelected(),
+				Contains("Add option"),
+				Contains("initial"),
+			)
+	},
+})
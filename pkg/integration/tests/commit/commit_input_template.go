@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitInputTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Insert commit message templates into the commit input, with their placeholders filled in",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Commit.Templates = []config.CommitTemplate{
+			{Name: "Ticket", Message: "[{{.TicketID}}] Update {{join .StagedFiles \", \"}}\n\nBranch: {{.BranchName}}"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("../template.txt", "# Describe what changed\nChange \n\n# Why?\n")
+		shell.SetConfig("commit.template", "../template.txt")
+		shell.EmptyCommit("initial")
+		shell.NewBranch("feature/ABC-123-resize")
+		shell.CreateFileAndAdd("layout.go", "content")
+		shell.CreateFileAndAdd("view.go", "content")
+		shell.CreateFile("unstaged.go", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Press(keys.CommitMessage.CommitMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Menu")).
+			Select(Contains("Insert template")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit message templates")).
+			Lines(
+				Contains("Git commit template").IsSelected(),
+				Contains("Ticket"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Ticket")).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("[ABC-123] Update layout.go, view.go\n\nBranch: feature/ABC-123-resize")).
+			Press(keys.CommitMessage.CommitMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Menu")).
+			Select(Contains("Insert template")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit message templates")).
+			Select(Contains("Git commit template")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Replace commit message")).
+			Content(Equals("Are you sure you want to replace the current commit message?")).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("Change"))
+	},
+})
@@ -0,0 +1,56 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitWithLintViolations = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Committing a message that breaks lint rules asks for confirmation first",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Commit.Lint = config.CommitLintConfig{
+			Types:            []string{"feat", "fix"},
+			RequiredTrailers: []config.CommitLintTrailer{{Key: "Signed-off-by"}},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("wip: stuff").
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Commit message problems")).
+			Content(Contains("- unknown type 'wip'\n- missing 'Signed-off-by' trailer")).
+			Cancel()
+
+		t.Views().Commits().
+			IsEmpty()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("wip: stuff")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Commit message problems")).
+			Content(Contains("Commit anyway?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("wip: stuff"),
+			)
+	},
+})
@@ -0,0 +1,112 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditTrailersRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add and remove trailers on a range of commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("third commit")
+		shell.EmptyCommit("second commit\n\nRefs: ABC-1")
+		shell.EmptyCommit("first commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+				Contains("second commit"),
+				Contains("third commit"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Edit trailers")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Trailers")).
+					Lines(
+						Contains("Add trailer"),
+						Contains("Refs: ABC-1"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Add trailer")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Add trailer")).
+					Select(Contains("Reviewed-by")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Value for 'Reviewed-by'")).
+					Type("John Smith <jsmith@gmail.com>").
+					Confirm()
+			}).
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Edit trailers")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Trailers")).
+					Lines(
+						Contains("Add trailer"),
+						Contains("Refs: ABC-1"),
+						Contains("Reviewed-by: John Smith <jsmith@gmail.com>"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Refs: ABC-1")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Refs: ABC-1")).
+					Select(Contains("Remove")).
+					Confirm()
+			}).
+			// exit range selection mode
+			PressEscape().
+			Lines(
+				Contains("first commit"),
+				Contains("second commit"),
+				Contains("third commit").IsSelected(),
+			)
+
+		t.Views().Main().ContainsLines(
+			Equals("    third commit"),
+			Equals("    "),
+			Equals("    Reviewed-by: John Smith <jsmith@gmail.com>"),
+		)
+
+		t.Views().Commits().
+			SelectPreviousItem()
+
+		t.Views().Main().
+			ContainsLines(
+				Equals("    second commit"),
+				Equals("    "),
+				Equals("    Reviewed-by: John Smith <jsmith@gmail.com>"),
+			).
+			Content(DoesNotContain("Refs: ABC-1"))
+
+		t.Views().Commits().
+			SelectPreviousItem()
+
+		t.Views().Main().Content(
+			Contains("first commit").
+				DoesNotContain("Reviewed-by"),
+		)
+	},
+})
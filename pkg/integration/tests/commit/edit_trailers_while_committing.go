@@ -0,0 +1,91 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditTrailersWhileCommitting = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add and remove trailers while typing the commit message",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("file", "file content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction(). // stage file
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("Subject").
+			SwitchToDescription().
+			Type("Here's my message.")
+
+		t.Views().CommitDescription().
+			IsFocused().
+			Press(keys.CommitMessage.CommitMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Commit Menu")).
+			Select(Contains("Edit trailers")).
+			Confirm()
+		t.ExpectPopup().Menu().Title(Equals("Trailers")).
+			Select(Contains("Add trailer")).
+			Confirm()
+		t.ExpectPopup().Menu().Title(Equals("Add trailer")).
+			Select(Contains("Other key...")).
+			Confirm()
+		t.ExpectPopup().Prompt().Title(Equals("Trailer key")).
+			Type("Refs").
+			Confirm()
+		t.ExpectPopup().Prompt().Title(Equals("Value for 'Refs'")).
+			Type("ABC-123").
+			Confirm()
+
+		t.Views().CommitDescription().
+			IsFocused().
+			Content(Equals("Here's my message.\n\nRefs: ABC-123")).
+			Press(keys.CommitMessage.CommitMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Commit Menu")).
+			Select(Contains("Edit trailers")).
+			Confirm()
+		t.ExpectPopup().Menu().Title(Equals("Trailers")).
+			Select(Contains("Refs: ABC-123")).
+			Confirm()
+		t.ExpectPopup().Menu().Title(Equals("Refs: ABC-123")).
+			Select(Contains("Edit value")).
+			Confirm()
+		t.ExpectPopup().Prompt().Title(Equals("Value for 'Refs'")).
+			InitialText(Equals("ABC-123")).
+			Clear().
+			Type("ABC-456").
+			Confirm()
+
+		t.Views().CommitDescription().
+			IsFocused().
+			Content(Equals("Here's my message.\n\nRefs: ABC-456")).
+			PressTab()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("Subject"),
+			).
+			Focus().
+			Tap(func() {
+				t.Views().Main().ContainsLines(
+					Equals("    Subject"),
+					Equals("    "),
+					Equals("    Here's my message."),
+					Equals("    "),
+					Equals("    Refs: ABC-456"),
+				)
+			})
+	},
+})
@@ -0,0 +1,50 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExportPatches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export a range of commits as a patch series with a cover letter",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.Commit("one")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("two")
+		shell.CreateFileAndAdd("file3", "three\n")
+		shell.Commit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.ExportPatches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Export as patch series")).
+			Select(Contains("Export patches with cover letter")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Output directory:")).
+			Type("patches").
+			Confirm()
+
+		t.ExpectToast(Equals("Exported 3 patch file(s) to patches"))
+
+		t.FileSystem().
+			PathPresent("patches/0000-cover-letter.patch").
+			FileContent("patches/0001-two.patch", Contains("Subject: [PATCH 1/2] two")).
+			FileContent("patches/0002-three.patch", Contains("Subject: [PATCH 2/2] three")).
+			PathNotPresent("patches/0003-one.patch")
+	},
+})
@@ -0,0 +1,112 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Notes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add, show, push and remove git notes of commits, with an extra notes ref configured",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.NotesRefs = []string{"review"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.RunCommand([]string{"git", "notes", "--ref=review", "add", "-m", "needs more tests", "HEAD^"})
+		shell.CloneIntoRemote("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").DoesNotContain("✎").IsSelected(),
+				Contains("✎ one"),
+			).
+			NavigateToLine(Contains("one"))
+
+		t.Views().Main().
+			Content(Contains("Notes (review):").Contains("needs more tests"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Select notes ref")).
+			Lines(
+				Equals("refs/notes/commits...").IsSelected(),
+				Equals("refs/notes/review..."),
+				Equals("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes (refs/notes/commits)")).
+			Select(Contains("Add note")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Note")).
+			Type("Looks good").
+			SwitchToDescription().
+			Type("Tested on linux").
+			SwitchToSummary().
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("✎ two").IsSelected(),
+				Contains("✎ one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Notes:").Contains("Looks good").Contains("Tested on linux"))
+
+		t.Views().Commits().
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Select notes ref")).
+			Select(Equals("refs/notes/commits...")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes (refs/notes/commits)")).
+			Select(Contains("Push notes to remote")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Push refs/notes/commits to remote:")).
+			InitialText(Equals("origin")).
+			Confirm()
+
+		t.Git().RemoteRefExists("origin", "refs/notes/commits")
+
+		t.Views().Commits().
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Select notes ref")).
+			Select(Equals("refs/notes/commits...")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes (refs/notes/commits)")).
+			Select(Contains("Remove note")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Remove note")).
+			Content(Contains("from refs/notes/commits?")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("two").DoesNotContain("✎").IsSelected(),
+				Contains("✎ one"),
+			)
+	},
+})
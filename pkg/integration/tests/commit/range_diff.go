@@ -0,0 +1,82 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare the local version of a rewritten branch with its upstream and with an earlier reflog entry",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("base", "base\n")
+		shell.Commit("base")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("file", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+		shell.Commit("add file")
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("add other")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("feature", "origin/feature")
+
+		// Rewrite the branch: change the first commit, drop the second one
+		// and add a new one
+		shell.HardReset("HEAD^")
+		shell.UpdateFileAndAdd("file", "1\n2\n3\n4\n5\n6\n7\n8\n9\nten\n")
+		shell.RunCommand([]string{"git", "commit", "--amend", "--no-edit"})
+		shell.CreateFileAndAdd("new", "new\n")
+		shell.Commit("add new")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.ViewRangeDiffOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View range-diff options")).
+			Select(Contains("Upstream vs. local branch")).
+			Confirm()
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Equals("Range diff (origin/feature → feature)")).
+			Lines(
+				Contains("1: ").Contains("!").Contains("1: ").Contains("add file").IsSelected(),
+				Contains("2: ").Contains("<").Contains("add other"),
+				Contains(">").Contains("2: ").Contains("add new"),
+			).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("-+10").Contains("++ten"))
+			}).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("This commit only exists in the old range.").Contains("+other"))
+			}).
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused()
+
+		t.Views().ReflogCommits().
+			Focus().
+			NavigateToLine(Contains("commit: add other")).
+			Press(keys.Commits.ViewRangeDiffOptions)
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Contains("→ HEAD")).
+			Lines(
+				Contains("add file").IsSelected(),
+				Contains("add other"),
+				Contains("add new"),
+			).
+			PressEscape()
+
+		t.Views().ReflogCommits().
+			IsFocused()
+	},
+})
@@ -0,0 +1,57 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeDiffAfterRebase = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare the upstream and the local version of a branch that was rebased onto a main branch that has moved",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("base", "base\n")
+		shell.Commit("base")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("file", "1\n2\n3\n")
+		shell.Commit("add file")
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("add other")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+		shell.SetBranchUpstream("feature", "origin/feature")
+
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("main-file", "main\n")
+		shell.Commit("change on master")
+		shell.PushBranch("origin", "master")
+
+		shell.Checkout("feature")
+		shell.RunCommand([]string{"git", "rebase", "master"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add other").IsSelected(),
+				Contains("add file"),
+				Contains("change on master"),
+				Contains("base"),
+			).
+			Press(keys.Commits.ViewRangeDiffOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View range-diff options")).
+			Select(Contains("Upstream vs. local branch")).
+			Confirm()
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Equals("Range diff (origin/feature → feature)")).
+			Lines(
+				Contains("1: ").Contains("=").Contains("1: ").Contains("add file").IsSelected(),
+				Contains("2: ").Contains("=").Contains("2: ").Contains("add other"),
+			)
+	},
+})
@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// Fails and fixes the file if it doesn't have the expected content
+var fixingHook = `#!/bin/sh
+
+if [ "$(cat file.txt)" != "fixed" ]; then
+  echo "fixed" > file.txt
+  echo "Rewrote file.txt"
+  exit 1
+fi
+`
+
+var RunPreCommitHooks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run the pre-commit hook without committing, stage the files it modified, and skip hooks for the next commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile(".git/hooks/pre-commit", fixingHook)
+		shell.MakeExecutable(".git/hooks/pre-commit")
+
+		shell.CreateFileAndAdd("file.txt", "broken")
+		shell.CreateFileAndAdd("other.txt", "other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.RunPreCommitHooks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pre-commit hook results")).
+			Lines(
+				Contains("✗").Contains("pre-commit").IsSelected(),
+				Contains("Stage files modified by hooks"),
+				Contains("Skip hooks when committing"),
+				Contains("Cancel"),
+			).
+			Tooltip(Equals("Rewrote file.txt")).
+			Select(Contains("Stage files modified by hooks")).
+			Tooltip(Equals("file.txt")).
+			Confirm()
+
+		t.FileSystem().FileContent("file.txt", Equals("fixed\n"))
+
+		// Nothing is left unstaged
+		t.Views().Files().
+			IsFocused().
+			IsEmpty().
+			Press(keys.Files.RunPreCommitHooks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pre-commit hook results")).
+			Lines(
+				Contains("✓").Contains("pre-commit").IsSelected(),
+				Contains("Stage files modified by hooks"),
+				Contains("Skip hooks when committing"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Stage files modified by hooks")).
+			Tooltip(Contains("The hooks didn't modify any staged files")).
+			Select(Contains("Skip hooks when committing")).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("WIP"))
+	},
+})
@@ -0,0 +1,44 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RunPreCommitHooksWithStagedSubmodule = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run the pre-commit hook when a submodule is staged along with a file that the hook modifies",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule_name", "my_submodule_path")
+
+		shell.CreateFile(".git/hooks/pre-commit", fixingHook)
+		shell.MakeExecutable(".git/hooks/pre-commit")
+
+		shell.CreateFileAndAdd("file.txt", "broken")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.RunPreCommitHooks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pre-commit hook results")).
+			Lines(
+				Contains("✗").Contains("pre-commit").IsSelected(),
+				Contains("Stage files modified by hooks"),
+				Contains("Skip hooks when committing"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Stage files modified by hooks")).
+			Tooltip(Equals("file.txt")).
+			Confirm()
+
+		t.FileSystem().FileContent("file.txt", Equals("fixed\n"))
+
+		t.Views().Files().
+			IsEmpty()
+	},
+})
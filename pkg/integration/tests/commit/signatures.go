@@ -0,0 +1,43 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Signatures = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the signature status of SSH-signed commits, with and without an allowed signers file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowCommitSignatures = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunCommand([]string{"ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "", "-f", ".git/signing_key"})
+		shell.SetConfig("gpg.format", "ssh")
+		shell.SetConfig("user.signingkey", ".git/signing_key.pub")
+
+		shell.EmptyCommit("unsigned")
+		shell.RunCommand([]string{"git", "commit", "--allow-empty", "-S", "-m", "signed"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("✓ signed").IsSelected(),
+				Contains("unsigned").DoesNotContain("✓"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("gpg.ssh.allowedSignersFile isn't configured").
+					Contains(`Good "git" signature with ED25519 key`),
+			)
+
+		t.Views().Commits().
+			NavigateToLine(Contains("unsigned"))
+
+		t.Views().Main().
+			Content(DoesNotContain("signature"))
+	},
+})
@@ -0,0 +1,89 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var TestCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run a test command on a range of commits, and keep the results when a commit is reworded",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Log.ShowGraph = "never"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(2)
+		shell.CreateFileAndAdd("broken.txt", "broken")
+		shell.Commit("break it")
+		shell.DeleteFileAndAdd("broken.txt")
+		shell.Commit("fix it")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("fix it").IsSelected(),
+				Contains("break it"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.ViewTestOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Test commits")).
+					Select(MatchesRegexp("Test selected commits$")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to test each commit with:")).
+					Type("test ! -f broken.txt || (echo broken.txt is there; false)").
+					Confirm()
+
+				t.ExpectToast(Equals("Tested commits: 2 passed, 1 failed"))
+			}).
+			Lines(
+				Contains("✔ fix it").IsSelected(),
+				Contains("✘ break it").IsSelected(),
+				Contains("✔ commit 02").IsSelected(),
+				Contains("commit 01").DoesNotContain("✔"),
+			).
+			PressEscape().
+			NavigateToLine(Contains("break it"))
+
+		t.Views().Secondary().
+			Title(Equals("Test output")).
+			Content(Contains("broken.txt is there"))
+
+		t.Views().Commits().
+			Press(keys.Commits.RenameCommit).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Clear().
+					Type("break it badly").
+					Confirm()
+			}).
+			// The tree hasn't changed, so the result still applies
+			Lines(
+				Contains("✔ fix it"),
+				Contains("✘ break it badly").IsSelected(),
+				Contains("✔ commit 02"),
+				Contains("commit 01").DoesNotContain("✔"),
+			).
+			Press(keys.Commits.ViewTestOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Test commits")).
+					Select(Contains("Clear test results")).
+					Confirm()
+			}).
+			Lines(
+				Contains("fix it").DoesNotContain("✔"),
+				Contains("break it badly").DoesNotContain("✘"),
+				Contains("commit 02").DoesNotContain("✔"),
+				Contains("commit 01"),
+			)
+	},
+})
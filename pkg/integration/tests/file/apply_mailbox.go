@@ -0,0 +1,64 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyMailbox = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply patches from a mailbox, resolving a conflict and continuing the am",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "original\n")
+		shell.Commit("initial")
+		shell.UpdateFileAndAdd("file", "patched\n")
+		shell.Commit("change file")
+		shell.CreateFileAndAdd("other", "other\n")
+		shell.Commit("add other")
+		shell.RunCommand([]string{"git", "format-patch", "--output", "../series.mbox", "HEAD~2..HEAD"})
+
+		shell.HardReset("HEAD~2")
+		shell.UpdateFileAndAdd("file", "local\n")
+		shell.Commit("local change")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ApplyMailbox)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path of mbox or patch file:")).
+			Type("../series.mbox").
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Status().
+			Content(Contains("(applying patches)"))
+
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("UU file")).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectNextItem().
+			PressPrimaryAction() // pick the patched version
+
+		t.Common().ContinueOnConflictsResolved("am")
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add other"),
+				Contains("change file"),
+				Contains("local change"),
+				Contains("initial"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("patched\n"))
+	},
+})
@@ -0,0 +1,90 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Blame = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file from the files and commit files panels, blame the parent of a line's commit, and jump to the commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Jane Doe", "jane@example.com")
+		shell.CreateFileAndAdd("file.txt", "first line\nsecond line\n")
+		shell.Commit("add file")
+		shell.SetAuthor("John Doe", "john@example.com")
+		shell.UpdateFileAndAdd("file.txt", "first line\nsecond line changed\n")
+		shell.Commit("change second line")
+		shell.EmptyCommit("unrelated commit")
+		shell.UpdateFile("file.txt", "first line\nsecond line changed\nthird line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file.txt").IsSelected(),
+			).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame: file.txt")).
+			Lines(
+				Contains("Jane Doe").Contains("1 first line").IsSelected(),
+				Contains("John Doe").Contains("2 second line changed"),
+				Contains("Not Committed Yet").Contains("3 third line"),
+			).
+			SelectNextItem().
+			Press(keys.Blame.BlameParentCommit).
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				Contains("Jane Doe").Contains("1 first line"),
+				Contains("Jane Doe").Contains("2 second line").IsSelected(),
+			).
+			Press(keys.Universal.Return).
+			Title(Equals("Blame: file.txt")).
+			Lines(
+				Contains("1 first line"),
+				Contains("2 second line changed").IsSelected(),
+				Contains("3 third line"),
+			).
+			Press(keys.Blame.ToggleIgnoreWhitespace).
+			Title(Equals("Blame: file.txt (-w)")).
+			Lines(
+				Contains("1 first line"),
+				Contains("2 second line changed").IsSelected(),
+				Contains("3 third line"),
+			).
+			Press(keys.Universal.GoInto)
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("unrelated commit"),
+				Contains("change second line").IsSelected(),
+				Contains("add file"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file.txt").IsSelected(),
+			).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				Contains("1 first line").IsSelected(),
+				Contains("2 second line changed"),
+			).
+			Press(keys.Universal.Return)
+
+		t.Views().CommitFiles().
+			IsFocused()
+	},
+})
@@ -0,0 +1,62 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BlameSearch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search the lines of a blamed file",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("Jane Doe", "jane@example.com")
+		shell.CreateFileAndAdd("file.txt", "apple\nbanana\ncherry\n")
+		shell.Commit("add file")
+		shell.SetAuthor("John Doe", "john@example.com")
+		shell.UpdateFileAndAdd("file.txt", "apple\nbanana\ncherry\npineapple\n")
+		shell.Commit("add pineapple")
+		shell.UpdateFileAndAdd("other.txt", "content")
+		shell.UpdateFile("file.txt", "apple\nbanana\ncherry\npineapple\ndate\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("file.txt")).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Lines(
+				Contains("1 apple").IsSelected(),
+				Contains("2 banana"),
+				Contains("3 cherry"),
+				Contains("4 pineapple"),
+				Contains("5 date"),
+			).
+			FilterOrSearch("apple").
+			SelectedLine(Contains("1 apple"))
+
+		t.Views().Search().Content(Contains("matches for 'apple' (1 of 2)"))
+
+		t.Views().Blame().
+			Press(keys.Universal.NextMatch).
+			SelectedLine(Contains("4 pineapple"))
+
+		t.Views().Search().Content(Contains("matches for 'apple' (2 of 2)"))
+
+		t.Views().Blame().
+			FilterOrSearch("john").
+			SelectedLine(Contains("John Doe").Contains("4 pineapple"))
+
+		t.Views().Search().Content(Contains("matches for 'john' (1 of 1)"))
+
+		t.Views().Blame().
+			Press(keys.Universal.Return).
+			Press(keys.Universal.Return)
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
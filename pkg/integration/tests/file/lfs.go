@@ -0,0 +1,76 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+const lfsPointer = `version https://git-lfs.github.com/spec/v1
+oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393
+size 12345
+`
+
+var Lfs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the LFS objects of files tracked by Git LFS instead of diffs of their pointer files",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitattributes", "*.bin filter=lfs diff=lfs merge=lfs -text\n")
+		shell.CreateFileAndAdd("image.bin", lfsPointer)
+		shell.CreateFileAndAdd("notes.txt", "one\n")
+		shell.Commit("add image")
+		shell.UpdateFile("image.bin", "hello")
+		shell.UpdateFile("notes.txt", "two\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("▼ /").IsSelected(),
+				Equals("   M image.bin (LFS) [+]"),
+				Equals("   M notes.txt [+]"),
+			).
+			NavigateToLine(Contains("image.bin"))
+
+		t.Views().Main().
+			Content(
+				Contains("This file is tracked by Git LFS").
+					Contains("- oid  sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393").
+					Contains("- size 12.1 KiB (12345 bytes)").
+					Contains("+ oid  sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824").
+					Contains("+ size 5 B (5 bytes)").
+					Contains("(content is stored in git directly, not in LFS)"),
+			)
+
+		t.Views().Files().
+			NavigateToLine(Contains("notes.txt"))
+
+		t.Views().Main().
+			Content(Contains("-one").Contains("+two").DoesNotContain("LFS"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add image").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("▼ /").IsSelected(),
+				Equals("  A .gitattributes"),
+				Equals("  A image.bin (LFS)"),
+				Equals("  A notes.txt"),
+			).
+			NavigateToLine(Contains("image.bin"))
+
+		t.Views().Main().
+			Content(
+				Contains("+ oid  sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393").
+					Contains("+ size 12.1 KiB (12345 bytes)").
+					DoesNotContain("- oid"),
+			)
+	},
+})
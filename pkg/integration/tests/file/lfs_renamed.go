@@ -0,0 +1,39 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LfsRenamed = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the LFS object of a renamed file tracked by Git LFS, looking it up under its previous path in HEAD",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".gitattributes", "*.bin filter=lfs diff=lfs merge=lfs -text\n")
+		shell.CreateFileAndAdd("image.bin", lfsPointer)
+		shell.Commit("add image")
+		shell.RunCommand([]string{"git", "mv", "image.bin", "renamed.bin"})
+		shell.UpdateFile("renamed.bin", "hello")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("renamed.bin"))
+
+		t.Views().Main().
+			Content(
+				Contains("- oid  sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393").
+					Contains("+ oid  sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+			)
+
+		// The staged rename compares the file in HEAD under its old path
+		// with the one in the index under its new path
+		t.Views().Secondary().
+			Content(
+				Contains("  oid  sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393").
+					Contains("The LFS object is unchanged."),
+			)
+	},
+})
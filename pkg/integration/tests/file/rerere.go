@@ -0,0 +1,103 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var Rerere = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Flag a file that rerere resolved, forget its resolution and delete the recorded conflict",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("rerere.enabled", "true")
+		shared.CreateMergeConflictFile(shell)
+		shell.UpdateFileAndAdd("file", shared.SecondChangeFileContent)
+		shell.ContinueMerge()
+
+		// Redo the merge, so that rerere resolves the conflict
+		shell.HardReset("HEAD^")
+		shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file (rerere)").IsSelected(),
+			).
+			Press(keys.Files.ViewRerereOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View rerere options")).
+			Select(Contains("View recorded resolutions")).
+			Confirm()
+
+		t.Views().RerereResolutions().
+			IsFocused().
+			Lines(
+				MatchesRegexp(` resolved\s+First Change`).IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("-First Change"))
+
+		t.Views().RerereResolutions().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewRerereOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View rerere options")).
+			Select(Contains("Forget recorded resolution")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Forget recorded resolution")).
+			Content(Contains("Are you sure you want to forget the recorded resolution of 'file'?")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file [+]").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("file", Contains("<<<<<<< ours"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewRerereOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View rerere options")).
+			Select(Contains("View recorded resolutions")).
+			Confirm()
+
+		t.Views().RerereResolutions().
+			IsFocused().
+			Lines(
+				MatchesRegexp(`unresolved\s+First Change`).IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Rerere recorded this conflict, but not its resolution yet."))
+
+		t.Views().RerereResolutions().
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete recorded resolution")).
+			Content(Equals("Are you sure you want to delete the selected recorded resolution(s)?")).
+			Confirm()
+
+		t.Views().RerereResolutions().
+			IsEmpty().
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
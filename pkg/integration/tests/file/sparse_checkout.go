@@ -0,0 +1,130 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SparseCheckout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enable a sparse checkout and add and remove directories from it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("top", "top")
+		shell.CreateFileAndAdd("lib/core/file1", "file1")
+		shell.CreateFileAndAdd("lib/extra/file2", "file2")
+		shell.CreateFileAndAdd("docs/file3", "file3")
+		shell.Commit("add files")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("View sparse checkout options")).
+			Select(Contains("Enable sparse checkout in cone mode")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("top").
+			PathNotPresent("lib/core/file1").
+			PathNotPresent("docs/file3")
+
+		t.Views().Files().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout (cone mode)")).
+			Lines(
+				Equals("Only the files at the top level are checked out."),
+				Equals(""),
+				Contains("--- Actions ---"),
+				Contains("Pick directories").IsSelected(),
+				Contains("Switch to non-cone mode"),
+				Contains("Disable sparse checkout"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add or remove directory")).
+			Lines(
+				MatchesRegexp(`docs/\s+not checked out`).IsSelected(),
+				MatchesRegexp(`lib/\s+not checked out`),
+				MatchesRegexp(`  core/\s+not checked out`),
+				MatchesRegexp(`  extra/\s+not checked out`),
+				Contains("Cancel"),
+			).
+			Select(Contains("core/")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("lib/core/file1").
+			PathNotPresent("lib/extra/file2")
+
+		t.Views().Files().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout (cone mode)")).
+			Select(Contains("Pick directories")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add or remove directory")).
+			Lines(
+				MatchesRegexp(`docs/\s+not checked out`).IsSelected(),
+				MatchesRegexp(`lib/\s+partially checked out`),
+				MatchesRegexp(`  core/\s*$`),
+				MatchesRegexp(`  extra/\s+not checked out`),
+				Contains("Cancel"),
+			).
+			Select(Contains("docs/")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("docs/file3")
+
+		t.Views().Files().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout (cone mode)")).
+			Lines(
+				Contains("--- Checked out directories ---"),
+				Contains("docs").IsSelected(),
+				Contains("lib/core"),
+				Equals("  "),
+				Contains("--- Actions ---"),
+				Contains("Pick directories"),
+				Contains("Switch to non-cone mode"),
+				Contains("Disable sparse checkout"),
+				Contains("Cancel"),
+			).
+			Select(Contains("lib/core")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Remove from sparse checkout")).
+			Content(Equals("Remove 'lib/core' from the sparse checkout? Its files will be removed from the working tree.")).
+			Confirm()
+
+		t.FileSystem().
+			PathNotPresent("lib/core/file1").
+			PathPresent("docs/file3")
+
+		t.Views().Files().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout (cone mode)")).
+			Select(Contains("Disable sparse checkout")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("lib/core/file1").
+			PathPresent("lib/extra/file2")
+	},
+})
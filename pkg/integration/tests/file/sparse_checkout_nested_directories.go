@@ -0,0 +1,60 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SparseCheckoutNestedDirectories = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pick a directory whose parent has no other children for the sparse checkout, and show that a staged file outside the sparse checkout isn't checked out",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("top", "top")
+		shell.CreateFileAndAdd("src/app/file1", "file1")
+		shell.CreateFileAndAdd("docs/file2", "file2")
+		shell.Commit("add files")
+		shell.UpdateFileAndAdd("src/app/file1", "file1 changed")
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "docs"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.FileSystem().
+			PathNotPresent("src/app/file1")
+
+		t.Views().StagedFiles().
+			Lines(
+				Contains("src/app"),
+				Contains("M  file1 (skip-worktree)"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewSparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse checkout (cone mode)")).
+			Select(Contains("Pick directories")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Add or remove directory")).
+			Lines(
+				MatchesRegexp(`docs/\s*$`).IsSelected(),
+				MatchesRegexp(`src/\s+not checked out`),
+				MatchesRegexp(`  app/\s+not checked out`),
+				Contains("Cancel"),
+			).
+			Select(Contains("app/")).
+			Confirm()
+
+		t.FileSystem().
+			PathPresent("src/app/file1")
+
+		t.Views().StagedFiles().
+			Lines(
+				Contains("src/app"),
+				Contains("M  file1").DoesNotContain("skip-worktree"),
+			)
+	},
+})
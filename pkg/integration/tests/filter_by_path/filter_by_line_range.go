@@ -0,0 +1,82 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilterByLineRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by the lines selected in the staging view, following their history with git log -L",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\nfour\nfive\nsix\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file", "one\ntwo changed\nthree\nfour\nfive\nsix\n")
+		shell.Commit("change line two")
+		shell.UpdateFileAndAdd("file", "one\ntwo changed\nthree\nfour\nfive changed\nsix\n")
+		shell.Commit("change line five")
+		shell.CreateFileAndAdd("otherFile", "content")
+		shell.Commit("add other file")
+
+		shell.UpdateFile("file", "one\ntwo changed\nthree\nfour\nfive changed again\nsix\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-five changed"),
+				Contains("+five changed again"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Filter by lines 5-5 of 'file'")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("change line five").IsSelected(),
+				Contains("add file"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by '5,5:file'"))
+
+		t.Views().Main().
+			Content(Contains("-five").Contains("+five changed").DoesNotContain("two changed"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("add file"))
+
+		t.Views().Main().
+			Content(Contains("+five").DoesNotContain("+one"))
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Stop filtering")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add other file"),
+				Contains("change line five"),
+				Contains("change line two"),
+				Contains("add file").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,92 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilterByLineRangeInCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by the lines selected in the patch building view of an older commit, whose line numbers differ from HEAD's",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\nthree\nfour\nfive\nsix\n")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file", "one\ntwo\nthree\nfour\nfive changed\nsix\n")
+		shell.Commit("change line five")
+		shell.UpdateFileAndAdd("file", "header 1\nheader 2\nheader 3\none\ntwo\nthree\nfour\nfive changed\nsix\n")
+		shell.Commit("add header")
+
+		// Staged and unstaged changes of the same file
+		shell.UpdateFileAndAdd("file", "header 1\nheader 2\nheader 3\none\ntwo\nthree\nfour\nfive changed\nsix\nseven\n")
+		shell.UpdateFile("file", "header 1\nheader 2\nheader 3\none\ntwo\nthree\nfour\nfive changed\nsix\nseven\neight\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		// The line numbers of the unstaged changes are the index's, which
+		// aren't HEAD's
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("+eight"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Filter by lines")).
+			Tooltip(Contains("Disabled: The file also has staged changes")).
+			Confirm().
+			Tap(func() {
+				t.ExpectToast(Contains("Disabled: The file also has staged changes"))
+			}).
+			Cancel()
+
+		t.Views().Staging().
+			PressEscape()
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("change line five")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().PatchBuilding().
+			IsFocused().
+			SelectedLines(
+				Contains("-five"),
+				Contains("+five changed"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Filter by lines 5-5 of 'file'")).
+			Confirm()
+
+		// The line range refers to the commit, so we start the log there
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("change line five").IsSelected(),
+				Contains("add file"),
+			)
+
+		t.Views().Main().
+			Content(Contains("-five").Contains("+five changed").DoesNotContain("header"))
+	},
+})
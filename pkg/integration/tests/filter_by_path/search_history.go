@@ -0,0 +1,109 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchHistory = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search the history for commits adding or removing a string and for commit messages, combined with a path filter",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("fileA", "foo\n")
+		shell.Commit("add foo to fileA")
+		shell.CreateFileAndAdd("fileB", "foo\n")
+		shell.Commit("add foo to fileB")
+		shell.UpdateFileAndAdd("fileA", "foo\nbar\n")
+		shell.Commit("add bar to fileA")
+		shell.UpdateFileAndAdd("fileA", "bar\n")
+		shell.Commit("remove foo from fileA")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Search history for a string that commits added or removed (-S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter string:")).
+			Type("foo").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo from fileA").IsSelected(),
+				Contains("add foo to fileB"),
+				Contains("add foo to fileA"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by -S 'foo'"))
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter path to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter path:")).
+			Type("fileA").
+			Confirm()
+
+		// Filtering by path cancels the search
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo from fileA").IsSelected(),
+				Contains("add bar to fileA"),
+				Contains("add foo to fileA"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		// but a search combines with the path filter
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("(-S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter string:")).
+			Type("foo").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("remove foo from fileA").IsSelected(),
+				Contains("add foo to fileA"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("(--grep)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter message pattern:")).
+			Type("^add").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add foo to fileA").IsSelected(),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by 'fileA' -S 'foo' --grep '^add'"))
+	},
+})
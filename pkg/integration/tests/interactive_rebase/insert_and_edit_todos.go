@@ -0,0 +1,150 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var InsertAndEditTodos = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Insert, edit and remove exec, label, reset and merge todos during an interactive rebase",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+		// Accept the default message of the merge commit
+		shell.SetConfig("core.editor", "true")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		insertTodo := func(menuItem string, promptTitle string, text string) {
+			t.Views().Commits().Press(keys.Commits.ViewRebaseTodoOptions)
+
+			t.ExpectPopup().Menu().
+				Title(Equals("Rebase todo options")).
+				Select(Contains(menuItem)).
+				Confirm()
+
+			t.ExpectPopup().Prompt().
+				Title(Equals(promptTitle)).
+				Type(text).
+				Confirm()
+		}
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("--- Commits ---"),
+				Contains("commit 02").IsSelected(),
+				Contains("commit 01"),
+			).
+			NavigateToLine(Contains("commit 03"))
+
+		insertTodo("Insert exec todo", "Command to execute:", "touch exec-ran")
+
+		t.Views().Commits().
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("exec").Contains("touch exec-ran").IsSelected(),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("--- Commits ---"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.ViewRebaseTodoOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rebase todo options")).
+					Select(Contains("Edit todo")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to execute:")).
+					InitialText(Equals("touch exec-ran")).
+					Clear().
+					Type("touch exec-edited").
+					Confirm()
+			}).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("exec").Contains("touch exec-edited").IsSelected(),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("--- Commits ---"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		insertTodo("Insert label todo", "Label name:", "feature")
+		insertTodo("Insert reset todo", "Reset to label:", "base")
+		insertTodo("Insert merge todo", "Label to merge:", "feature")
+		insertTodo("Insert exec todo", "Command to execute:", "false")
+
+		t.Views().Commits().
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("exec").Contains("false").IsSelected(),
+				Contains("merge").Contains("feature"),
+				Contains("reset").Contains("base"),
+				Contains("label").Contains("feature"),
+				Contains("exec").Contains("touch exec-edited"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("--- Commits ---"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.ViewRebaseTodoOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Rebase todo options")).
+					Select(Contains("Remove todo")).
+					Confirm()
+			}).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("merge").Contains("feature").IsSelected(),
+				Contains("reset").Contains("base"),
+				Contains("label").Contains("feature"),
+				Contains("exec").Contains("touch exec-edited"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("--- Commits ---"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			NavigateToLine(Contains("commit 02"))
+
+		// Inserting after the current commit makes the todo the first one
+		insertTodo("Insert label todo", "Label name:", "base")
+
+		t.Views().Commits().
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("merge").Contains("feature"),
+				Contains("reset").Contains("base"),
+				Contains("label").Contains("feature"),
+				Contains("exec").Contains("touch exec-edited"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("label").Contains("base").IsSelected(),
+				Contains("--- Commits ---"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Label the current commit as 'base' here"))
+
+		t.Common().ContinueRebase()
+
+		t.Views().Commits().
+			Lines(
+				Contains("Merge branch 'feature'"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.FileSystem().PathPresent("exec-edited")
+	},
+})
@@ -0,0 +1,60 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RegenerateCommitMessages = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Regenerates the messages of a range of commits, accepting one and keeping the old message of the other, without applying the branch's commit prefix",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Commit.Generator.Commands = []config.CommitGeneratorCommand{
+			{
+				Name:    "test",
+				Command: `printf 'Generated subject\n\nGenerated body\n'`,
+			},
+		}
+		cfg.GetUserConfig().Git.CommitPrefix = []config.CommitPrefixConfig{{Pattern: "^\\w+\\/(\\w+-\\w+).*", Replace: "[$1]: "}}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("feature/TEST-001")
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.RegenerateCommitMessage).
+			Tap(func() {
+				// Oldest commit first
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Regenerated commit message (1 of 2)")).
+					Content(Contains("Before:\ncommit 01").Contains("After:\nGenerated subject\n\nGenerated body").DoesNotContain("TEST-001")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Regenerated commit message (2 of 2)")).
+					Content(Contains("Before:\ncommit 02")).
+					Cancel()
+			}).
+			Lines(
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("Generated subject"),
+			).
+			// Cancel the range selection
+			PressEscape().
+			NavigateToLine(Contains("Generated subject"))
+
+		t.Views().Main().
+			Content(Contains("Generated body"))
+	},
+})
@@ -0,0 +1,68 @@
+package status
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoHealth = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the repository health dashboard in the status panel and run maintenance commands from it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.StatusPanelView = "health"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CloneIntoRemote("origin").
+			EmptyCommit("initial commit").
+			NewBranch("feature").
+			EmptyCommit("on feature").
+			PushBranchAndSetUpstream("origin", "feature").
+			RunCommand([]string{"git", "push", "origin", "--delete", "feature"}).
+			Checkout("master").
+			AddWorktree("master", "../linked-worktree", "linked-branch").
+			RunCommand([]string{"rm", "-rf", "../linked-worktree"}).
+			CreateFile("big.bin", strings.Repeat("x", 10*1024*1024)).
+			CreateFile("small.txt", "small")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Title(Equals("Repository health")).
+			Content(
+				Contains("Commit-graph:").
+					Contains("missing").
+					Contains("Stale worktrees:\n  linked-worktree").
+					Contains("Branches whose upstream is gone:\n  feature (origin/feature)").
+					Contains("Large untracked files:\n  big.bin (10.0 MiB)").
+					DoesNotContain("small.txt"),
+			)
+
+		t.Views().Status().
+			Press(keys.Status.RepoMaintenance)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository maintenance")).
+			Select(Contains("Prune stale worktrees")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("Stale worktrees: none"))
+
+		t.Views().Status().
+			Press(keys.Status.RepoMaintenance)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository maintenance")).
+			Select(Contains("Run the commit-graph maintenance task")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("Commit-graph:").Contains("present"))
+	},
+})
@@ -0,0 +1,53 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitAndPushAndSetUpstream = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit and push a branch that has no upstream yet with the commit and push button",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Equals("repo → master")).
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Type("two").
+			Press(keys.Universal.NextBlock)
+
+		// past the generate and commit buttons
+		t.GlobalPress(keys.Universal.NextBlock)
+		t.GlobalPress(keys.Universal.NextBlock)
+
+		t.Views().CommitPushButton().
+			IsFocused().
+			PressEnter()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Commit and push")).
+			Content(
+				Contains("✓ Committed 'two'").
+					Contains("✓ Pushed to origin/master and made it the upstream branch"),
+			).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("")).
+			Press(keys.Universal.PrevBlock)
+
+		assertSuccessfullyPushed(t)
+	},
+})
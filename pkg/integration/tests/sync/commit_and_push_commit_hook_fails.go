@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var failingPreCommitHook = `#!/bin/bash
+
+echo "hook says no" >&2
+exit 1
+`
+
+var CommitAndPushCommitHookFails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit and push with the commit and push button, where a hook rejects the commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.CreateFile(".git/hooks/pre-commit", failingPreCommitHook)
+		shell.MakeExecutable(".git/hooks/pre-commit")
+
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Type("two").
+			Press(keys.Universal.NextBlock)
+
+		// past the generate and commit buttons
+		t.GlobalPress(keys.Universal.NextBlock)
+		t.GlobalPress(keys.Universal.NextBlock)
+
+		t.Views().CommitPushButton().
+			IsFocused().
+			PressEnter()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Commit and push")).
+			Content(
+				Contains("✗ Committing failed, so nothing was pushed").
+					Contains("hook says no").
+					DoesNotContain("Pushed"),
+			).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("two")).
+			Press(keys.Universal.PrevBlock)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one"),
+			)
+	},
+})
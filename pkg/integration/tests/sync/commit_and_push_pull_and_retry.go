@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitAndPushPullAndRetry = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit and push with the commit and push button, pulling with --rebase when the push is rejected",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// remove the 'two' commit so that the remote has a commit that we don't have
+		shell.HardReset("HEAD^")
+
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Equals("↓1 repo → master")).
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Type("three").
+			Press(keys.Universal.NextBlock)
+
+		// past the generate and commit buttons
+		t.GlobalPress(keys.Universal.NextBlock)
+		t.GlobalPress(keys.Universal.NextBlock)
+
+		t.Views().CommitPushButton().
+			IsFocused().
+			PressEnter()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Push rejected")).
+			Content(Contains("pull them with --rebase and push again?")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Commit and push")).
+			Content(
+				Contains("✓ Committed 'three'").
+					Contains("✗ Pushing to origin/master was rejected").
+					Contains("✓ Pulled with --rebase").
+					Contains("✓ Pushed to origin/master"),
+			).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("")).
+			Press(keys.Universal.PrevBlock)
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
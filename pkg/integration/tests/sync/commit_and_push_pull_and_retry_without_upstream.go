@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitAndPushPullAndRetryWithoutUpstream = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit and push a branch that has no upstream with the commit and push button and push.default=current, pulling with --rebase when the push is rejected",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("push.default", "current")
+
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		// remove the 'two' commit so that the remote has a commit that we don't have
+		shell.HardReset("HEAD^")
+
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Equals("repo → master")).
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Type("three").
+			Press(keys.Universal.NextBlock)
+
+		// past the generate and commit buttons
+		t.GlobalPress(keys.Universal.NextBlock)
+		t.GlobalPress(keys.Universal.NextBlock)
+
+		t.Views().CommitPushButton().
+			IsFocused().
+			PressEnter()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Push rejected")).
+			Content(Contains("pull them with --rebase and push again?")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Commit and push")).
+			Content(
+				Contains("✓ Committed 'three'").
+					Contains("✗ Pushing to origin/master was rejected").
+					Contains("✓ Pulled with --rebase").
+					Contains("✓ Pushed to origin/master and made it the upstream branch"),
+			).
+			Confirm()
+
+		t.Views().CommitInput().
+			IsFocused().
+			Content(Equals("")).
+			Press(keys.Universal.PrevBlock)
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
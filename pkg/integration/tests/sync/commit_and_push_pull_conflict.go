@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitAndPushPullConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commit and push with the commit and push button, where pulling with --rebase after the rejected push stops with conflicts",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "content2")
+		shell.Commit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// remove the 'two' commit so that the remote has a commit that we don't have
+		shell.HardReset("HEAD^")
+
+		shell.UpdateFileAndAdd("file", "content3")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Universal.NextBlock)
+
+		t.Views().CommitInput().
+			Type("three").
+			Press(keys.Universal.NextBlock)
+
+		// past the generate and commit buttons
+		t.GlobalPress(keys.Universal.NextBlock)
+		t.GlobalPress(keys.Universal.NextBlock)
+
+		t.Views().CommitPushButton().
+			IsFocused().
+			PressEnter()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Push rejected")).
+			Content(Contains("pull them with --rebase and push again?")).
+			Confirm()
+
+		t.Common().AcknowledgeConflicts()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file"),
+			)
+
+		t.Views().Status().Content(Contains("(rebasing)"))
+	},
+})
@@ -38,6 +38,7 @@ var tests = []*components.IntegrationTest{
 	bisect.Basic,
 	bisect.ChooseTerms,
 	bisect.FromOtherBranch,
+	bisect.Run,
 	bisect.Skip,
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
@@ -87,6 +88,8 @@ var tests = []*components.IntegrationTest{
 	branch.SortLocalBranches,
 	branch.SortRemoteBranches,
 	branch.SquashMerge,
+	branch.StackPush,
+	branch.StackRestackAndInsertBranch,
 	branch.Suggestions,
 	branch.UnsetUpstream,
 	cherry_pick.CherryPick,
@@ -96,6 +99,9 @@ var tests = []*components.IntegrationTest{
 	cherry_pick.CherryPickDuringRebase,
 	cherry_pick.CherryPickMerge,
 	cherry_pick.CherryPickRange,
+	commit.AbsorbStagedChanges,
+	commit.AbsorbStagedChangesAndSquash,
+	commit.AbsorbStagedChangesHookFails,
 	commit.AddCoAuthor,
 	commit.AddCoAuthorRange,
 	commit.AddCoAuthorWhileCommitting,
@@ -109,6 +115,8 @@ var tests = []*components.IntegrationTest{
 	commit.CheckoutFileFromRangeSelectionOfCommits,
 	commit.CheckoutFileWithLocalModifications,
 	commit.Commit,
+	commit.CommitInputHistory,
+	commit.CommitInputTemplate,
 	commit.CommitMultiline,
 	commit.CommitSkipHooks,
 	commit.CommitSwitchToEditor,
@@ -116,6 +124,7 @@ var tests = []*components.IntegrationTest{
 	commit.CommitWipWithPrefix,
 	commit.CommitWithFallthroughPrefix,
 	commit.CommitWithGlobalPrefix,
+	commit.CommitWithLintViolations,
 	commit.CommitWithNonMatchingBranchName,
 	commit.CommitWithPrefix,
 	commit.CopyAuthorToClipboard,
@@ -128,6 +137,9 @@ var tests = []*components.IntegrationTest{
 	commit.DiscardOldFileChanges,
 	commit.DiscardSubmoduleChanges,
 	commit.DoNotShowBranchMarkerForHeadCommit,
+	commit.EditTrailersRange,
+	commit.EditTrailersWhileCommitting,
+	commit.ExportPatches,
 	commit.FailHooksThenCommitNoHooks,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupDisregardFixupsForSameBaseCommit,
@@ -138,9 +150,12 @@ var tests = []*components.IntegrationTest{
 	commit.History,
 	commit.HistoryComplex,
 	commit.NewBranch,
+	commit.Notes,
 	commit.PasteCommitMessage,
 	commit.PasteCommitMessageOverExisting,
 	commit.PreserveCommitMessage,
+	commit.RangeDiff,
+	commit.RangeDiffAfterRebase,
 	commit.ResetAuthor,
 	commit.ResetAuthorRange,
 	commit.Revert,
@@ -148,12 +163,16 @@ var tests = []*components.IntegrationTest{
 	commit.RevertWithConflictMultipleCommits,
 	commit.RevertWithConflictSingleCommit,
 	commit.Reword,
+	commit.RunPreCommitHooks,
+	commit.RunPreCommitHooksWithStagedSubmodule,
 	commit.Search,
 	commit.SetAuthor,
 	commit.SetAuthorRange,
+	commit.Signatures,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
+	commit.TestCommits,
 	commit.Unstaged,
 	config.CustomCommandsInPerRepoConfig,
 	config.NegativeRefspec,
@@ -210,6 +229,9 @@ var tests = []*components.IntegrationTest{
 	diff.DiffNonStickyRange,
 	diff.IgnoreWhitespace,
 	diff.RenameSimilarityThresholdChange,
+	file.ApplyMailbox,
+	file.Blame,
+	file.BlameSearch,
 	file.CollapseExpand,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
@@ -223,10 +245,15 @@ var tests = []*components.IntegrationTest{
 	file.DiscardVariousChangesRangeSelect,
 	file.Gitignore,
 	file.GitignoreSpecialCharacters,
+	file.Lfs,
+	file.LfsRenamed,
 	file.RememberCommitMessageAfterFail,
 	file.RenameSimilarityThresholdChange,
 	file.RenamedFiles,
 	file.RenamedFilesNoRootItem,
+	file.Rerere,
+	file.SparseCheckout,
+	file.SparseCheckoutNestedDirectories,
 	file.StageChildrenRangeSelect,
 	file.StageDeletedRangeSelect,
 	file.StageRangeSelect,
@@ -251,8 +278,11 @@ var tests = []*components.IntegrationTest{
 	filter_by_author.TypeAuthor,
 	filter_by_path.CliArg,
 	filter_by_path.DropCommitInFilteringMode,
+	filter_by_path.FilterByLineRange,
+	filter_by_path.FilterByLineRangeInCommit,
 	filter_by_path.KeepSameCommitSelectedOnExit,
 	filter_by_path.RewordCommitInFilteringMode,
+	filter_by_path.SearchHistory,
 	filter_by_path.SelectFile,
 	filter_by_path.SelectFilteredFileWhenEnteringCommit,
 	filter_by_path.SelectFilteredFileWhenEnteringCommitNoRootItem,
@@ -282,6 +312,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.FixupKeepMessage,
 	interactive_rebase.FixupKeepMessageRebase,
 	interactive_rebase.FixupSecondCommit,
+	interactive_rebase.InsertAndEditTodos,
 	interactive_rebase.InteractiveRebaseOfCopiedBranch,
 	interactive_rebase.InteractiveRebaseWithConflictForEditCommand,
 	interactive_rebase.MidRebaseRangeSelect,
@@ -297,6 +328,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.QuickStartKeepSelectionRange,
 	interactive_rebase.Rebase,
 	interactive_rebase.RebaseWithCommitThatBecomesEmpty,
+	interactive_rebase.RegenerateCommitMessages,
 	interactive_rebase.RevertDuringRebaseWhenStoppedOnEdit,
 	interactive_rebase.RevertMultipleCommitsInInteractiveRebase,
 	interactive_rebase.RevertSingleCommitInInteractiveRebase,
@@ -396,6 +428,7 @@ var tests = []*components.IntegrationTest{
 	status.ClickWorkingTreeStateToOpenRebaseOptionsMenu,
 	status.LogCmd,
 	status.LogCmdStatusPanelAllBranchesLog,
+	status.RepoHealth,
 	submodule.Add,
 	submodule.Enter,
 	submodule.EnterNested,
@@ -403,6 +436,11 @@ var tests = []*components.IntegrationTest{
 	submodule.RemoveNested,
 	submodule.Reset,
 	submodule.ResetFolder,
+	sync.CommitAndPushAndSetUpstream,
+	sync.CommitAndPushCommitHookFails,
+	sync.CommitAndPushPullAndRetry,
+	sync.CommitAndPushPullAndRetryWithoutUpstream,
+	sync.CommitAndPushPullConflict,
 	sync.FetchAndAutoForwardBranchesAllBranches,
 	sync.FetchAndAutoForwardBranchesAllBranchesCheckedOutInOtherWorktree,
 	sync.FetchAndAutoForwardBranchesNone,
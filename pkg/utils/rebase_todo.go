@@ -287,10 +287,26 @@ func RemoveUpdateRefsForCopiedBranch(fileName string, commentChar byte) error {
 	return nil
 }
 
-// We render a todo in the commits view if it's a commit or if it's an
-// update-ref or exec. We don't render label, reset, or comment lines.
+// We render a todo in the commits view if it's a commit, or (only while a
+// rebase is in progress) if it's one of the todos without a commit that
+// IsRenderedTodoWithoutCommit accepts. We don't render break or comment lines.
 func isRenderedTodo(t todo.Todo, isInRebase bool) bool {
-	return t.Commit != "" || (isInRebase && (t.Command == todo.UpdateRef || t.Command == todo.Exec))
+	return t.Commit != "" || (isInRebase && IsRenderedTodoWithoutCommit(t))
+}
+
+// Update-ref, exec, label, reset, and merge todos are shown in the commits view
+// even though they have no commit, except for the "label onto" and "reset
+// onto" todos that git adds to every todo list when using --rebase-merges;
+// these would only be noise.
+func IsRenderedTodoWithoutCommit(t todo.Todo) bool {
+	switch t.Command {
+	case todo.UpdateRef, todo.Exec, todo.Merge:
+		return true
+	case todo.Label, todo.Reset:
+		return t.Label != "onto"
+	default:
+		return false
+	}
 }
 
 func DropMergeCommit(fileName string, hash string, commentChar byte) error {
@@ -318,3 +334,146 @@ func dropMergeCommit(todos []todo.Todo, hash string) ([]todo.Todo, error) {
 	_, idx, _ := lo.FindIndexOf(todos, isMerge)
 	return slices.Delete(todos, idx, idx+1), nil
 }
+
+// The functions below identify a todo by its index among the todos that we
+// render in the commits view while a rebase is in progress. Since the commits
+// view shows the todos in reverse order, index 0 is the last rendered todo in
+// the file. This is the only way to refer to todos that don't have a hash,
+// e.g. when there are several identical exec todos.
+
+// Inserts newTodo right after the rendered todo with the given index, so that
+// it is executed after it. If the index is equal to the number of rendered
+// todos, newTodo is inserted at the beginning, so that it is executed first.
+func InsertTodo(fileName string, todoIndex int, newTodo todo.Todo, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	newTodos, err := insertTodo(todos, todoIndex, newTodo)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+func insertTodo(todos []todo.Todo, todoIndex int, newTodo todo.Todo) ([]todo.Todo, error) {
+	if todoIndex == lo.CountBy(todos, func(t todo.Todo) bool { return isRenderedTodo(t, true) }) {
+		// Insert it before the first rendered todo, but after git's "label
+		// onto" and "reset onto" todos, so that it doesn't affect them
+		_, idx, ok := lo.FindIndexOf(todos, func(t todo.Todo) bool { return isRenderedTodo(t, true) })
+		if !ok {
+			idx = len(todos)
+		}
+		return slices.Insert(slices.Clone(todos), idx, newTodo), nil
+	}
+
+	idx, ok := findRenderedTodo(todos, todoIndex)
+	if !ok {
+		return nil, fmt.Errorf("Todo %d not found in git-rebase-todo", todoIndex)
+	}
+
+	return slices.Insert(slices.Clone(todos), idx+1, newTodo), nil
+}
+
+// Replaces the rendered todo with the given index by newTodo. The todo must
+// currently have the given command; this protects against editing the wrong
+// todo if the file was changed in the meantime.
+func ReplaceTodo(fileName string, todoIndex int, oldCommand todo.TodoCommand, newTodo todo.Todo, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	newTodos, err := replaceTodo(todos, todoIndex, oldCommand, newTodo)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+func replaceTodo(todos []todo.Todo, todoIndex int, oldCommand todo.TodoCommand, newTodo todo.Todo) ([]todo.Todo, error) {
+	idx, err := findRenderedTodoWithCommand(todos, todoIndex, oldCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	newTodos := slices.Clone(todos)
+	newTodos[idx] = newTodo
+	return newTodos, nil
+}
+
+// Removes the rendered todo with the given index, which must currently have
+// the given command.
+func RemoveTodo(fileName string, todoIndex int, oldCommand todo.TodoCommand, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	newTodos, err := removeTodo(todos, todoIndex, oldCommand)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+func removeTodo(todos []todo.Todo, todoIndex int, oldCommand todo.TodoCommand) ([]todo.Todo, error) {
+	idx, err := findRenderedTodoWithCommand(todos, todoIndex, oldCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Delete(slices.Clone(todos), idx, idx+1), nil
+}
+
+// Returns the rendered todo with the given index
+func GetTodo(fileName string, todoIndex int, commentChar byte) (todo.Todo, error) {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return todo.Todo{}, err
+	}
+
+	idx, ok := findRenderedTodo(todos, todoIndex)
+	if !ok {
+		return todo.Todo{}, fmt.Errorf("Todo %d not found in git-rebase-todo", todoIndex)
+	}
+
+	return todos[idx], nil
+}
+
+func findRenderedTodo(todos []todo.Todo, todoIndex int) (int, bool) {
+	if todoIndex < 0 {
+		return -1, false
+	}
+
+	count := 0
+	for i := len(todos) - 1; i >= 0; i-- {
+		if !isRenderedTodo(todos[i], true) {
+			continue
+		}
+		if count == todoIndex {
+			return i, true
+		}
+		count++
+	}
+
+	return -1, false
+}
+
+func findRenderedTodoWithCommand(todos []todo.Todo, todoIndex int, command todo.TodoCommand) (int, error) {
+	idx, ok := findRenderedTodo(todos, todoIndex)
+	if !ok {
+		return -1, fmt.Errorf("Todo %d not found in git-rebase-todo", todoIndex)
+	}
+
+	if todos[idx].Command != command {
+		// Should never happen
+		return -1, fmt.Errorf("Todo %d in git-rebase-todo is a %s todo, expected %s", todoIndex, todos[idx].Command, command)
+	}
+
+	return idx, nil
+}
@@ -553,6 +553,173 @@ func TestRebaseCommands_deleteTodos(t *testing.T) {
 	}
 }
 
+func TestRebaseCommands_insertTodo(t *testing.T) {
+	todos := []todo.Todo{
+		{Command: todo.Label, Label: "onto"},
+		{Command: todo.Pick, Commit: "1234"},
+		{Command: todo.Break},
+		{Command: todo.Exec, ExecCommand: "make test"},
+		{Command: todo.Pick, Commit: "5678"},
+	}
+
+	scenarios := []struct {
+		name          string
+		todoIndex     int
+		expectedTodos []todo.Todo
+		expectedErr   error
+	}{
+		{
+			name:      "after last todo",
+			todoIndex: 0,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Break},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "echo new"},
+			},
+		},
+		{
+			name:      "after todo without hash",
+			todoIndex: 1,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Break},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Exec, ExecCommand: "echo new"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			name:      "skips todos that are not rendered",
+			todoIndex: 2,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "echo new"},
+				{Command: todo.Break},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			name:      "at the beginning, after git's label onto",
+			todoIndex: 3,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Exec, ExecCommand: "echo new"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Break},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			name:        "out of range",
+			todoIndex:   4,
+			expectedErr: errors.New("Todo 4 not found in git-rebase-todo"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos, actualErr := insertTodo(todos, scenario.todoIndex, todo.Todo{Command: todo.Exec, ExecCommand: "echo new"})
+
+			if scenario.expectedErr == nil {
+				assert.NoError(t, actualErr)
+			} else {
+				assert.EqualError(t, actualErr, scenario.expectedErr.Error())
+			}
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
+func TestRebaseCommands_replaceTodo(t *testing.T) {
+	todos := []todo.Todo{
+		{Command: todo.Reset, Label: "base"},
+		{Command: todo.Pick, Commit: "1234"},
+		{Command: todo.Merge, Label: "feature"},
+		{Command: todo.Exec, ExecCommand: "make test"},
+	}
+
+	scenarios := []struct {
+		name          string
+		todoIndex     int
+		oldCommand    todo.TodoCommand
+		newTodo       todo.Todo
+		expectedTodos []todo.Todo
+		expectedErr   error
+	}{
+		{
+			name:       "edit exec command",
+			todoIndex:  0,
+			oldCommand: todo.Exec,
+			newTodo:    todo.Todo{Command: todo.Exec, ExecCommand: "make lint"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Reset, Label: "base"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Merge, Label: "feature"},
+				{Command: todo.Exec, ExecCommand: "make lint"},
+			},
+		},
+		{
+			name:       "edit label of reset",
+			todoIndex:  3,
+			oldCommand: todo.Reset,
+			newTodo:    todo.Todo{Command: todo.Reset, Label: "other"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Reset, Label: "other"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Merge, Label: "feature"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+		},
+		{
+			name:        "unexpected command",
+			todoIndex:   1,
+			oldCommand:  todo.Exec,
+			newTodo:     todo.Todo{Command: todo.Exec, ExecCommand: "make lint"},
+			expectedErr: errors.New("Todo 1 in git-rebase-todo is a merge todo, expected exec"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos, actualErr := replaceTodo(todos, scenario.todoIndex, scenario.oldCommand, scenario.newTodo)
+
+			if scenario.expectedErr == nil {
+				assert.NoError(t, actualErr)
+			} else {
+				assert.EqualError(t, actualErr, scenario.expectedErr.Error())
+			}
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
+func TestRebaseCommands_removeTodo(t *testing.T) {
+	todos := []todo.Todo{
+		{Command: todo.Exec, ExecCommand: "make test"},
+		{Command: todo.Pick, Commit: "1234"},
+		{Command: todo.Exec, ExecCommand: "make test"},
+	}
+
+	actualTodos, err := removeTodo(todos, 2, todo.Exec)
+	assert.NoError(t, err)
+	assert.EqualValues(t, []todo.Todo{
+		{Command: todo.Pick, Commit: "1234"},
+		{Command: todo.Exec, ExecCommand: "make test"},
+	}, actualTodos)
+
+	_, err = removeTodo(todos, 1, todo.Exec)
+	assert.EqualError(t, err, "Todo 1 in git-rebase-todo is a pick todo, expected exec")
+}
+
 func Test_equalHash(t *testing.T) {
 	scenarios := []struct {
 		a        string